@@ -10,10 +10,12 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-chi/chi/v5"
@@ -21,6 +23,18 @@ import (
 	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
 )
 
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for GameStatus.
+const (
+	Final     GameStatus = "final"
+	Live      GameStatus = "live"
+	Postponed GameStatus = "postponed"
+	Scheduled GameStatus = "scheduled"
+)
+
 // Defines values for PlayerRole.
 const (
 	PlayerRoleC  PlayerRole = "C"
@@ -30,6 +44,27 @@ const (
 	PlayerRoleSG PlayerRole = "SG"
 )
 
+// Defines values for PlayerAuditOperation.
+const (
+	PlayerAuditOperationCreate  PlayerAuditOperation = "create"
+	PlayerAuditOperationDelete  PlayerAuditOperation = "delete"
+	PlayerAuditOperationRestore PlayerAuditOperation = "restore"
+	PlayerAuditOperationUpdate  PlayerAuditOperation = "update"
+)
+
+// Defines values for PlayerBatchMode.
+const (
+	Atomic     PlayerBatchMode = "atomic"
+	BestEffort PlayerBatchMode = "best_effort"
+)
+
+// Defines values for PlayerBatchOperationOp.
+const (
+	PlayerBatchOperationOpCreate PlayerBatchOperationOp = "create"
+	PlayerBatchOperationOpDelete PlayerBatchOperationOp = "delete"
+	PlayerBatchOperationOpUpdate PlayerBatchOperationOp = "update"
+)
+
 // Defines values for PlayerCreateRole.
 const (
 	PlayerCreateRoleC  PlayerCreateRole = "C"
@@ -39,20 +74,139 @@ const (
 	PlayerCreateRoleSG PlayerCreateRole = "SG"
 )
 
+// Defines values for PlayerPosition.
+const (
+	PlayerPositionC  PlayerPosition = "C"
+	PlayerPositionPF PlayerPosition = "PF"
+	PlayerPositionPG PlayerPosition = "PG"
+	PlayerPositionSF PlayerPosition = "SF"
+	PlayerPositionSG PlayerPosition = "SG"
+)
+
+// Defines values for PlayerSortKey.
+const (
+	Age              PlayerSortKey = "age"
+	Citizenship      PlayerSortKey = "citizenship"
+	Height           PlayerSortKey = "height"
+	Id               PlayerSortKey = "id"
+	MinusAge         PlayerSortKey = "-age"
+	MinusCitizenship PlayerSortKey = "-citizenship"
+	MinusHeight      PlayerSortKey = "-height"
+	MinusId          PlayerSortKey = "-id"
+	MinusName        PlayerSortKey = "-name"
+	MinusRole        PlayerSortKey = "-role"
+	MinusSurname     PlayerSortKey = "-surname"
+	MinusTeamId      PlayerSortKey = "-teamId"
+	MinusWeight      PlayerSortKey = "-weight"
+	Name             PlayerSortKey = "name"
+	Role             PlayerSortKey = "role"
+	Surname          PlayerSortKey = "surname"
+	TeamId           PlayerSortKey = "teamId"
+	Weight           PlayerSortKey = "weight"
+)
+
 // Defines values for PlayerUpdateRole.
 const (
-	C  PlayerUpdateRole = "C"
-	PF PlayerUpdateRole = "PF"
-	PG PlayerUpdateRole = "PG"
-	SF PlayerUpdateRole = "SF"
-	SG PlayerUpdateRole = "SG"
+	PlayerUpdateRoleC  PlayerUpdateRole = "C"
+	PlayerUpdateRolePF PlayerUpdateRole = "PF"
+	PlayerUpdateRolePG PlayerUpdateRole = "PG"
+	PlayerUpdateRoleSF PlayerUpdateRole = "SF"
+	PlayerUpdateRoleSG PlayerUpdateRole = "SG"
+)
+
+// Defines values for ExportPlayersParamsFormat.
+const (
+	Csv    ExportPlayersParamsFormat = "csv"
+	Ndjson ExportPlayersParamsFormat = "ndjson"
+	Xlsx   ExportPlayersParamsFormat = "xlsx"
 )
 
+// Defines values for ExportPlayersParamsUnits.
+const (
+	Human ExportPlayersParamsUnits = "human"
+	Raw   ExportPlayersParamsUnits = "raw"
+)
+
+// FieldError defines model for FieldError.
+type FieldError struct {
+	// Field Name of the parameter or JSON pointer of the body field
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// Game defines model for Game.
+type Game struct {
+	// AwayScore Points of the away team, set on live and final games
+	AwayScore  *int32 `json:"awayScore,omitempty"`
+	AwayTeamId int64  `json:"awayTeamId"`
+
+	// HomeScore Points of the home team, set on live and final games
+	HomeScore   *int32    `json:"homeScore,omitempty"`
+	HomeTeamId  int64     `json:"homeTeamId"`
+	Id          int64     `json:"id"`
+	ScheduledAt time.Time `json:"scheduledAt"`
+
+	// Status Progress of the game. Live games may carry the current score, final games
+	// must have one and scheduled or postponed games have none.
+	Status GameStatus `json:"status"`
+	Venue  string     `json:"venue"`
+}
+
+// GameCreate A game without a status is scheduled.
+type GameCreate struct {
+	AwayScore *int32 `json:"awayScore,omitempty"`
+
+	// AwayTeamId Must differ from `homeTeamId`
+	AwayTeamId  int64     `json:"awayTeamId"`
+	HomeScore   *int32    `json:"homeScore,omitempty"`
+	HomeTeamId  int64     `json:"homeTeamId"`
+	ScheduledAt time.Time `json:"scheduledAt"`
+
+	// Status Progress of the game. Live games may carry the current score, final games
+	// must have one and scheduled or postponed games have none.
+	Status *GameStatus `json:"status,omitempty"`
+	Venue  string      `json:"venue"`
+}
+
+// GameStatus Progress of the game. Live games may carry the current score, final games
+// must have one and scheduled or postponed games have none.
+type GameStatus string
+
+// GameUpdate Full replacement of the game fields, scores left out are cleared.
+type GameUpdate struct {
+	AwayScore *int32 `json:"awayScore,omitempty"`
+
+	// AwayTeamId Must differ from `homeTeamId`
+	AwayTeamId  int64     `json:"awayTeamId"`
+	HomeScore   *int32    `json:"homeScore,omitempty"`
+	HomeTeamId  int64     `json:"homeTeamId"`
+	ScheduledAt time.Time `json:"scheduledAt"`
+
+	// Status Progress of the game. Live games may carry the current score, final games
+	// must have one and scheduled or postponed games have none.
+	Status GameStatus `json:"status"`
+	Venue  string     `json:"venue"`
+}
+
+// PageLinks defines model for PageLinks.
+type PageLinks struct {
+	First string `json:"first"`
+
+	// Last Present when paging by `page_number`
+	Last *string `json:"last,omitempty"`
+	Next *string `json:"next,omitempty"`
+	Prev *string `json:"prev,omitempty"`
+	Self string  `json:"self"`
+}
+
 // Player defines model for Player.
 type Player struct {
 	Age         int    `json:"age"`
 	Citizenship string `json:"citizenship"`
 
+	// DeletedAt When the player was deleted, only set on deleted players
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+
 	// Height Height in millimeters (e.g., 2060 mm = 2.06 m)
 	Height  int        `json:"height"`
 	Id      int64      `json:"id"`
@@ -61,6 +215,9 @@ type Player struct {
 	Surname string     `json:"surname"`
 	TeamId  int64      `json:"teamId"`
 
+	// Version Incremented on every change, the `ETag` of the player
+	Version int64 `json:"version"`
+
 	// Weight Weight in grams (e.g., 113000 g = 113.0 kg)
 	Weight int `json:"weight"`
 }
@@ -68,6 +225,70 @@ type Player struct {
 // PlayerRole defines model for Player.Role.
 type PlayerRole string
 
+// PlayerAuditEntry defines model for PlayerAuditEntry.
+type PlayerAuditEntry struct {
+	// Actor Caller that made the change as `<method>:<subject>`, e.g. `jwt:alice` or `api_key:ci`,
+	// or `system` for changes made outside of a request
+	Actor     string               `json:"actor"`
+	After     *Player              `json:"after,omitempty"`
+	Before    *Player              `json:"before,omitempty"`
+	ChangedAt time.Time            `json:"changed_at"`
+	Id        int64                `json:"id"`
+	Operation PlayerAuditOperation `json:"operation"`
+	PlayerId  int64                `json:"player_id"`
+}
+
+// PlayerAuditOperation defines model for PlayerAuditOperation.
+type PlayerAuditOperation string
+
+// PlayerBatchMode defines model for PlayerBatchMode.
+type PlayerBatchMode string
+
+// PlayerBatchOperation defines model for PlayerBatchOperation.
+type PlayerBatchOperation struct {
+	// Changes Fields to update.
+	Changes *PlayerUpdate `json:"changes,omitempty"`
+
+	// Id Player to update or delete
+	Id     *int64                 `json:"id,omitempty"`
+	Op     PlayerBatchOperationOp `json:"op"`
+	Player *PlayerCreate          `json:"player,omitempty"`
+
+	// Version Version of the player to update or delete, a mismatch fails with 412
+	Version *int64 `json:"version,omitempty"`
+}
+
+// PlayerBatchOperationOp defines model for PlayerBatchOperation.Op.
+type PlayerBatchOperationOp string
+
+// PlayerBatchRequest defines model for PlayerBatchRequest.
+type PlayerBatchRequest struct {
+	Mode       *PlayerBatchMode       `json:"mode,omitempty"`
+	Operations []PlayerBatchOperation `json:"operations"`
+}
+
+// PlayerBatchResponse defines model for PlayerBatchResponse.
+type PlayerBatchResponse struct {
+	Failed    int                 `json:"failed"`
+	Mode      PlayerBatchMode     `json:"mode"`
+	Results   []PlayerBatchResult `json:"results"`
+	Succeeded int                 `json:"succeeded"`
+}
+
+// PlayerBatchResult defines model for PlayerBatchResult.
+type PlayerBatchResult struct {
+	// Index Position of the operation in the request
+	Index  int     `json:"index"`
+	Player *Player `json:"player,omitempty"`
+
+	// Problem Error details (RFC 7807). Every error response, including validation failures
+	// and internal errors, carries a problem document.
+	Problem *Problem `json:"problem,omitempty"`
+
+	// Status HTTP status the operation would have had on its own, 424 when it was rolled back
+	Status int `json:"status"`
+}
+
 // PlayerCreate defines model for PlayerCreate.
 type PlayerCreate struct {
 	Age         int    `json:"age"`
@@ -87,6 +308,64 @@ type PlayerCreate struct {
 // PlayerCreateRole defines model for PlayerCreate.Role.
 type PlayerCreateRole string
 
+// PlayerHistory defines model for PlayerHistory.
+type PlayerHistory struct {
+	Items []PlayerAuditEntry `json:"items"`
+
+	// NextCursor Cursor of the next page, absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// PlayerImportRejection defines model for PlayerImportRejection.
+type PlayerImportRejection struct {
+	Errors []FieldError `json:"errors"`
+
+	// Line Line of the upload the row starts at, the CSV header is line 1
+	Line int `json:"line"`
+}
+
+// PlayerImportReport defines model for PlayerImportReport.
+type PlayerImportReport struct {
+	DryRun bool `json:"dry_run"`
+
+	// Imported Number of players created, or that would be created by a dry run
+	Imported int                     `json:"imported"`
+	Rejected []PlayerImportRejection `json:"rejected"`
+
+	// Rows Number of data rows in the upload
+	Rows int `json:"rows"`
+}
+
+// PlayerList defines model for PlayerList.
+type PlayerList struct {
+	Items []Player  `json:"items"`
+	Links PageLinks `json:"links"`
+
+	// NextCursor Cursor of the next page, absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+
+	// PageNumber Page number, present when paging by `page_number`
+	PageNumber *int32 `json:"page_number,omitempty"`
+	PageSize   int32  `json:"page_size"`
+
+	// Total Number of players matching the filters
+	Total int64 `json:"total"`
+}
+
+// PlayerPosition defines model for PlayerPosition.
+type PlayerPosition string
+
+// PlayerSearchResult defines model for PlayerSearchResult.
+type PlayerSearchResult struct {
+	Player Player `json:"player"`
+
+	// Rank Relevance of the match, higher is better
+	Rank float64 `json:"rank"`
+}
+
+// PlayerSortKey Player field to sort by, prefixed with `-` for descending order.
+type PlayerSortKey string
+
 // PlayerUpdate Fields to update.
 type PlayerUpdate struct {
 	Age         *int    `json:"age,omitempty"`
@@ -106,8 +385,324 @@ type PlayerUpdate struct {
 // PlayerUpdateRole defines model for PlayerUpdate.Role.
 type PlayerUpdateRole string
 
+// Problem Error details (RFC 7807). Every error response, including validation failures
+// and internal errors, carries a problem document.
+type Problem struct {
+	// Detail Explanation specific to this occurrence of the problem
+	Detail *string `json:"detail,omitempty"`
+
+	// Errors Invalid fields of the request, present on validation failures
+	Errors *[]FieldError `json:"errors,omitempty"`
+
+	// Instance Path of the request that caused the problem
+	Instance *string `json:"instance,omitempty"`
+
+	// RequestId ID of the request, also returned in the `X-Request-ID` header
+	RequestId *string `json:"request_id,omitempty"`
+
+	// Status HTTP status code
+	Status int `json:"status"`
+
+	// Title Short summary of the kind of problem
+	Title string `json:"title"`
+
+	// Type URI identifying the kind of problem
+	Type string `json:"type"`
+}
+
+// Team defines model for Team.
+type Team struct {
+	City string `json:"city"`
+	Id   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+// TeamCreate defines model for TeamCreate.
+type TeamCreate struct {
+	City string `json:"city"`
+	Name string `json:"name"`
+}
+
+// TeamUpdate Full replacement of the team fields.
+type TeamUpdate struct {
+	City string `json:"city"`
+	Name string `json:"name"`
+}
+
+// IdempotencyKey defines model for IdempotencyKey.
+type IdempotencyKey = string
+
+// IfMatch defines model for IfMatch.
+type IfMatch = string
+
+// IfNoneMatch defines model for IfNoneMatch.
+type IfNoneMatch = string
+
+// PlayerCitizenshipFilter defines model for PlayerCitizenshipFilter.
+type PlayerCitizenshipFilter = string
+
+// PlayerCursor defines model for PlayerCursor.
+type PlayerCursor = string
+
+// PlayerIncludeDeletedFilter defines model for PlayerIncludeDeletedFilter.
+type PlayerIncludeDeletedFilter = bool
+
+// PlayerMaxAgeFilter defines model for PlayerMaxAgeFilter.
+type PlayerMaxAgeFilter = int
+
+// PlayerMaxHeightFilter defines model for PlayerMaxHeightFilter.
+type PlayerMaxHeightFilter = int
+
+// PlayerMaxWeightFilter defines model for PlayerMaxWeightFilter.
+type PlayerMaxWeightFilter = int
+
+// PlayerMinAgeFilter defines model for PlayerMinAgeFilter.
+type PlayerMinAgeFilter = int
+
+// PlayerMinHeightFilter defines model for PlayerMinHeightFilter.
+type PlayerMinHeightFilter = int
+
+// PlayerMinWeightFilter defines model for PlayerMinWeightFilter.
+type PlayerMinWeightFilter = int
+
+// PlayerPageNumber defines model for PlayerPageNumber.
+type PlayerPageNumber = int32
+
+// PlayerPageSize defines model for PlayerPageSize.
+type PlayerPageSize = int32
+
+// PlayerRoleFilter defines model for PlayerRoleFilter.
+type PlayerRoleFilter = PlayerPosition
+
+// PlayerSort defines model for PlayerSort.
+type PlayerSort = []PlayerSortKey
+
+// PlayerTeamIdFilter defines model for PlayerTeamIdFilter.
+type PlayerTeamIdFilter = int64
+
+// BadRequest Error details (RFC 7807). Every error response, including validation failures
+// and internal errors, carries a problem document.
+type BadRequest = Problem
+
+// Conflict Error details (RFC 7807). Every error response, including validation failures
+// and internal errors, carries a problem document.
+type Conflict = Problem
+
+// ContentTooLarge Error details (RFC 7807). Every error response, including validation failures
+// and internal errors, carries a problem document.
+type ContentTooLarge = Problem
+
+// Forbidden Error details (RFC 7807). Every error response, including validation failures
+// and internal errors, carries a problem document.
+type Forbidden = Problem
+
+// NotAcceptable Error details (RFC 7807). Every error response, including validation failures
+// and internal errors, carries a problem document.
+type NotAcceptable = Problem
+
+// NotFound Error details (RFC 7807). Every error response, including validation failures
+// and internal errors, carries a problem document.
+type NotFound = Problem
+
+// PreconditionFailed Error details (RFC 7807). Every error response, including validation failures
+// and internal errors, carries a problem document.
+type PreconditionFailed = Problem
+
+// PreconditionRequired Error details (RFC 7807). Every error response, including validation failures
+// and internal errors, carries a problem document.
+type PreconditionRequired = Problem
+
+// ServiceUnavailable Error details (RFC 7807). Every error response, including validation failures
+// and internal errors, carries a problem document.
+type ServiceUnavailable = Problem
+
+// Unauthorized Error details (RFC 7807). Every error response, including validation failures
+// and internal errors, carries a problem document.
+type Unauthorized = Problem
+
+// UnprocessableContent Error details (RFC 7807). Every error response, including validation failures
+// and internal errors, carries a problem document.
+type UnprocessableContent = Problem
+
+// UnsupportedMediaType Error details (RFC 7807). Every error response, including validation failures
+// and internal errors, carries a problem document.
+type UnsupportedMediaType = Problem
+
+// ListGamesParams defines parameters for ListGames.
+type ListGamesParams struct {
+	// TeamId Only games the team with this ID plays in, at home or away
+	TeamId *int64 `form:"team_id,omitempty" json:"team_id,omitempty"`
+
+	// From Only games scheduled at or after this time (inclusive)
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only games scheduled before this time (exclusive)
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// PageNumber Page number (starts from 1)
+	PageNumber *int32 `form:"page_number,omitempty" json:"page_number,omitempty"`
+
+	// PageSize Number of items per page (maximum 100)
+	PageSize *int32 `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
 // ListPlayersParams defines parameters for ListPlayers.
 type ListPlayersParams struct {
+	// PageNumber Page number (starts from 1). Selects offset pagination, the first page is returned when omitted.
+	PageNumber *PlayerPageNumber `form:"page_number,omitempty" json:"page_number,omitempty"`
+
+	// PageSize Number of items per page (maximum 100)
+	PageSize *PlayerPageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// TeamId Only players of the team with this ID
+	TeamId *PlayerTeamIdFilter `form:"team_id,omitempty" json:"team_id,omitempty"`
+
+	// Role Only players with this role
+	Role *PlayerRoleFilter `form:"role,omitempty" json:"role,omitempty"`
+
+	// Citizenship Only players with this citizenship
+	Citizenship *PlayerCitizenshipFilter `form:"citizenship,omitempty" json:"citizenship,omitempty"`
+
+	// MinAge Minimum age (inclusive)
+	MinAge *PlayerMinAgeFilter `form:"min_age,omitempty" json:"min_age,omitempty"`
+
+	// MaxAge Maximum age (inclusive)
+	MaxAge *PlayerMaxAgeFilter `form:"max_age,omitempty" json:"max_age,omitempty"`
+
+	// MinHeight Minimum height in millimeters (inclusive)
+	MinHeight *PlayerMinHeightFilter `form:"min_height,omitempty" json:"min_height,omitempty"`
+
+	// MaxHeight Maximum height in millimeters (inclusive)
+	MaxHeight *PlayerMaxHeightFilter `form:"max_height,omitempty" json:"max_height,omitempty"`
+
+	// MinWeight Minimum weight in grams (inclusive)
+	MinWeight *PlayerMinWeightFilter `form:"min_weight,omitempty" json:"min_weight,omitempty"`
+
+	// MaxWeight Maximum weight in grams (inclusive)
+	MaxWeight *PlayerMaxWeightFilter `form:"max_weight,omitempty" json:"max_weight,omitempty"`
+
+	// IncludeDeleted Also list deleted players that are not purged yet (league_admin only)
+	IncludeDeleted *PlayerIncludeDeletedFilter `form:"include_deleted,omitempty" json:"include_deleted,omitempty"`
+
+	// Sort Comma-separated sort keys, applied in order. A leading `-` sorts in descending order,
+	// e.g. `sort=-age,surname`. Players are always ordered by `id` last, so pages are stable.
+	Sort *PlayerSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Cursor Opaque cursor from the `next` link of a previous page. Continues the listing right
+	// after the last player of that page, even if players were added or removed meanwhile.
+	// Must be used with the same `sort` as the previous page and cannot be combined with `page_number`.
+	Cursor *PlayerCursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// CreatePlayerParams defines parameters for CreatePlayer.
+type CreatePlayerParams struct {
+	// IdempotencyKey Unique key of the request chosen by the client, e.g. a UUID. Keys are kept for the
+	// configured TTL per caller. A key still in progress is answered with 409.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// ExportPlayersParams defines parameters for ExportPlayers.
+type ExportPlayersParams struct {
+	// TeamId Only players of the team with this ID
+	TeamId *PlayerTeamIdFilter `form:"team_id,omitempty" json:"team_id,omitempty"`
+
+	// Role Only players with this role
+	Role *PlayerRoleFilter `form:"role,omitempty" json:"role,omitempty"`
+
+	// Citizenship Only players with this citizenship
+	Citizenship *PlayerCitizenshipFilter `form:"citizenship,omitempty" json:"citizenship,omitempty"`
+
+	// MinAge Minimum age (inclusive)
+	MinAge *PlayerMinAgeFilter `form:"min_age,omitempty" json:"min_age,omitempty"`
+
+	// MaxAge Maximum age (inclusive)
+	MaxAge *PlayerMaxAgeFilter `form:"max_age,omitempty" json:"max_age,omitempty"`
+
+	// MinHeight Minimum height in millimeters (inclusive)
+	MinHeight *PlayerMinHeightFilter `form:"min_height,omitempty" json:"min_height,omitempty"`
+
+	// MaxHeight Maximum height in millimeters (inclusive)
+	MaxHeight *PlayerMaxHeightFilter `form:"max_height,omitempty" json:"max_height,omitempty"`
+
+	// MinWeight Minimum weight in grams (inclusive)
+	MinWeight *PlayerMinWeightFilter `form:"min_weight,omitempty" json:"min_weight,omitempty"`
+
+	// MaxWeight Maximum weight in grams (inclusive)
+	MaxWeight *PlayerMaxWeightFilter `form:"max_weight,omitempty" json:"max_weight,omitempty"`
+
+	// IncludeDeleted Also list deleted players that are not purged yet (league_admin only)
+	IncludeDeleted *PlayerIncludeDeletedFilter `form:"include_deleted,omitempty" json:"include_deleted,omitempty"`
+
+	// Sort Comma-separated sort keys, applied in order. A leading `-` sorts in descending order,
+	// e.g. `sort=-age,surname`. Players are always ordered by `id` last, so pages are stable.
+	Sort *PlayerSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Format Export format, takes precedence over `Accept`
+	Format *ExportPlayersParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Units Write height and weight as stored (mm, g) or in meters and kilograms
+	Units *ExportPlayersParamsUnits `form:"units,omitempty" json:"units,omitempty"`
+}
+
+// ExportPlayersParamsFormat defines parameters for ExportPlayers.
+type ExportPlayersParamsFormat string
+
+// ExportPlayersParamsUnits defines parameters for ExportPlayers.
+type ExportPlayersParamsUnits string
+
+// ImportPlayersParams defines parameters for ImportPlayers.
+type ImportPlayersParams struct {
+	// DryRun Check the upload without importing it
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// SearchPlayersParams defines parameters for SearchPlayers.
+type SearchPlayersParams struct {
+	// Q Search text, e.g. `Doncic` or `Luka Doncic`
+	Q string `form:"q" json:"q"`
+
+	// Limit Maximum number of results
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// DeletePlayerParams defines parameters for DeletePlayer.
+type DeletePlayerParams struct {
+	// IfMatch Required. The `ETag` of the player the change is based on, or `*` to match any version.
+	// A missing header is rejected with 428, a stale one with 412.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetPlayerParams defines parameters for GetPlayer.
+type GetPlayerParams struct {
+	// IfNoneMatch Entity tags the client has cached, a match is answered with 304
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// PatchPlayerParams defines parameters for PatchPlayer.
+type PatchPlayerParams struct {
+	// IfMatch Required. The `ETag` of the player the change is based on, or `*` to match any version.
+	// A missing header is rejected with 428, a stale one with 412.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// UpdatePlayerParams defines parameters for UpdatePlayer.
+type UpdatePlayerParams struct {
+	// IfMatch Required. The `ETag` of the player the change is based on, or `*` to match any version.
+	// A missing header is rejected with 428, a stale one with 412.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetPlayerHistoryParams defines parameters for GetPlayerHistory.
+type GetPlayerHistoryParams struct {
+	// PageSize Number of items per page (maximum 100)
+	PageSize *PlayerPageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// Cursor Opaque cursor from `next_cursor` of the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// ListTeamsParams defines parameters for ListTeams.
+type ListTeamsParams struct {
 	// PageNumber Page number (starts from 1)
 	PageNumber *int32 `form:"page_number,omitempty" json:"page_number,omitempty"`
 
@@ -115,35 +710,185 @@ type ListPlayersParams struct {
 	PageSize *int32 `form:"page_size,omitempty" json:"page_size,omitempty"`
 }
 
+// ListPlayersV2Params defines parameters for ListPlayersV2.
+type ListPlayersV2Params struct {
+	// PageNumber Page number (starts from 1). Selects offset pagination, the first page is returned when omitted.
+	PageNumber *PlayerPageNumber `form:"page_number,omitempty" json:"page_number,omitempty"`
+
+	// PageSize Number of items per page (maximum 100)
+	PageSize *PlayerPageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// TeamId Only players of the team with this ID
+	TeamId *PlayerTeamIdFilter `form:"team_id,omitempty" json:"team_id,omitempty"`
+
+	// Role Only players with this role
+	Role *PlayerRoleFilter `form:"role,omitempty" json:"role,omitempty"`
+
+	// Citizenship Only players with this citizenship
+	Citizenship *PlayerCitizenshipFilter `form:"citizenship,omitempty" json:"citizenship,omitempty"`
+
+	// MinAge Minimum age (inclusive)
+	MinAge *PlayerMinAgeFilter `form:"min_age,omitempty" json:"min_age,omitempty"`
+
+	// MaxAge Maximum age (inclusive)
+	MaxAge *PlayerMaxAgeFilter `form:"max_age,omitempty" json:"max_age,omitempty"`
+
+	// MinHeight Minimum height in millimeters (inclusive)
+	MinHeight *PlayerMinHeightFilter `form:"min_height,omitempty" json:"min_height,omitempty"`
+
+	// MaxHeight Maximum height in millimeters (inclusive)
+	MaxHeight *PlayerMaxHeightFilter `form:"max_height,omitempty" json:"max_height,omitempty"`
+
+	// MinWeight Minimum weight in grams (inclusive)
+	MinWeight *PlayerMinWeightFilter `form:"min_weight,omitempty" json:"min_weight,omitempty"`
+
+	// MaxWeight Maximum weight in grams (inclusive)
+	MaxWeight *PlayerMaxWeightFilter `form:"max_weight,omitempty" json:"max_weight,omitempty"`
+
+	// IncludeDeleted Also list deleted players that are not purged yet (league_admin only)
+	IncludeDeleted *PlayerIncludeDeletedFilter `form:"include_deleted,omitempty" json:"include_deleted,omitempty"`
+
+	// Sort Comma-separated sort keys, applied in order. A leading `-` sorts in descending order,
+	// e.g. `sort=-age,surname`. Players are always ordered by `id` last, so pages are stable.
+	Sort *PlayerSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Cursor Opaque cursor from the `next` link of a previous page. Continues the listing right
+	// after the last player of that page, even if players were added or removed meanwhile.
+	// Must be used with the same `sort` as the previous page and cannot be combined with `page_number`.
+	Cursor *PlayerCursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// CreateGameJSONRequestBody defines body for CreateGame for application/json ContentType.
+type CreateGameJSONRequestBody = GameCreate
+
+// UpdateGameJSONRequestBody defines body for UpdateGame for application/json ContentType.
+type UpdateGameJSONRequestBody = GameUpdate
+
 // CreatePlayerJSONRequestBody defines body for CreatePlayer for application/json ContentType.
 type CreatePlayerJSONRequestBody = PlayerCreate
 
+// PatchPlayerApplicationMergePatchPlusJSONRequestBody defines body for PatchPlayer for application/merge-patch+json ContentType.
+type PatchPlayerApplicationMergePatchPlusJSONRequestBody = PlayerUpdate
+
 // UpdatePlayerJSONRequestBody defines body for UpdatePlayer for application/json ContentType.
 type UpdatePlayerJSONRequestBody = PlayerUpdate
 
+// BatchPlayersJSONRequestBody defines body for BatchPlayers for application/json ContentType.
+type BatchPlayersJSONRequestBody = PlayerBatchRequest
+
+// CreateTeamJSONRequestBody defines body for CreateTeam for application/json ContentType.
+type CreateTeamJSONRequestBody = TeamCreate
+
+// UpdateTeamJSONRequestBody defines body for UpdateTeam for application/json ContentType.
+type UpdateTeamJSONRequestBody = TeamUpdate
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Get the schedule of games
+	// (GET /games)
+	ListGames(w http.ResponseWriter, r *http.Request, params ListGamesParams)
+	// Schedule a new game
+	// (POST /games)
+	CreateGame(w http.ResponseWriter, r *http.Request)
+	// Delete game by ID
+	// (DELETE /games/{id})
+	DeleteGame(w http.ResponseWriter, r *http.Request, id int64)
+	// Get game by ID
+	// (GET /games/{id})
+	GetGame(w http.ResponseWriter, r *http.Request, id int64)
+	// Update game by ID
+	// (PUT /games/{id})
+	UpdateGame(w http.ResponseWriter, r *http.Request, id int64)
 	// Get list of all players
 	// (GET /players)
 	ListPlayers(w http.ResponseWriter, r *http.Request, params ListPlayersParams)
 	// Create a new player
 	// (POST /players)
-	CreatePlayer(w http.ResponseWriter, r *http.Request)
+	CreatePlayer(w http.ResponseWriter, r *http.Request, params CreatePlayerParams)
+	// Export players as CSV, NDJSON or XLSX
+	// (GET /players/export)
+	ExportPlayers(w http.ResponseWriter, r *http.Request, params ExportPlayersParams)
+	// Import players from CSV or NDJSON
+	// (POST /players/import)
+	ImportPlayers(w http.ResponseWriter, r *http.Request, params ImportPlayersParams)
+	// Search players by name
+	// (GET /players/search)
+	SearchPlayers(w http.ResponseWriter, r *http.Request, params SearchPlayersParams)
 	// Delete player by ID
 	// (DELETE /players/{id})
-	DeletePlayer(w http.ResponseWriter, r *http.Request, id int64)
+	DeletePlayer(w http.ResponseWriter, r *http.Request, id int64, params DeletePlayerParams)
 	// Get player by ID
 	// (GET /players/{id})
-	GetPlayer(w http.ResponseWriter, r *http.Request, id int64)
+	GetPlayer(w http.ResponseWriter, r *http.Request, id int64, params GetPlayerParams)
+	// Partially update player by ID
+	// (PATCH /players/{id})
+	PatchPlayer(w http.ResponseWriter, r *http.Request, id int64, params PatchPlayerParams)
 	// Update player by ID
 	// (PUT /players/{id})
-	UpdatePlayer(w http.ResponseWriter, r *http.Request, id int64)
+	UpdatePlayer(w http.ResponseWriter, r *http.Request, id int64, params UpdatePlayerParams)
+	// Get the change history of a player
+	// (GET /players/{id}/history)
+	GetPlayerHistory(w http.ResponseWriter, r *http.Request, id int64, params GetPlayerHistoryParams)
+	// Restore a deleted player
+	// (POST /players/{id}:restore)
+	RestorePlayer(w http.ResponseWriter, r *http.Request, id int64)
+	// Create, update and delete players in bulk
+	// (POST /players:batch)
+	BatchPlayers(w http.ResponseWriter, r *http.Request)
+	// Get list of all teams
+	// (GET /teams)
+	ListTeams(w http.ResponseWriter, r *http.Request, params ListTeamsParams)
+	// Create a new team
+	// (POST /teams)
+	CreateTeam(w http.ResponseWriter, r *http.Request)
+	// Delete team by ID
+	// (DELETE /teams/{id})
+	DeleteTeam(w http.ResponseWriter, r *http.Request, id int64)
+	// Get team by ID
+	// (GET /teams/{id})
+	GetTeam(w http.ResponseWriter, r *http.Request, id int64)
+	// Update team by ID
+	// (PUT /teams/{id})
+	UpdateTeam(w http.ResponseWriter, r *http.Request, id int64)
+	// Get a page of players with paging metadata
+	// (GET /v2/players)
+	ListPlayersV2(w http.ResponseWriter, r *http.Request, params ListPlayersV2Params)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
 type Unimplemented struct{}
 
+// Get the schedule of games
+// (GET /games)
+func (_ Unimplemented) ListGames(w http.ResponseWriter, r *http.Request, params ListGamesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Schedule a new game
+// (POST /games)
+func (_ Unimplemented) CreateGame(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete game by ID
+// (DELETE /games/{id})
+func (_ Unimplemented) DeleteGame(w http.ResponseWriter, r *http.Request, id int64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get game by ID
+// (GET /games/{id})
+func (_ Unimplemented) GetGame(w http.ResponseWriter, r *http.Request, id int64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update game by ID
+// (PUT /games/{id})
+func (_ Unimplemented) UpdateGame(w http.ResponseWriter, r *http.Request, id int64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get list of all players
 // (GET /players)
 func (_ Unimplemented) ListPlayers(w http.ResponseWriter, r *http.Request, params ListPlayersParams) {
@@ -152,46 +897,154 @@ func (_ Unimplemented) ListPlayers(w http.ResponseWriter, r *http.Request, param
 
 // Create a new player
 // (POST /players)
-func (_ Unimplemented) CreatePlayer(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) CreatePlayer(w http.ResponseWriter, r *http.Request, params CreatePlayerParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Export players as CSV, NDJSON or XLSX
+// (GET /players/export)
+func (_ Unimplemented) ExportPlayers(w http.ResponseWriter, r *http.Request, params ExportPlayersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Import players from CSV or NDJSON
+// (POST /players/import)
+func (_ Unimplemented) ImportPlayers(w http.ResponseWriter, r *http.Request, params ImportPlayersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Search players by name
+// (GET /players/search)
+func (_ Unimplemented) SearchPlayers(w http.ResponseWriter, r *http.Request, params SearchPlayersParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
 // Delete player by ID
 // (DELETE /players/{id})
-func (_ Unimplemented) DeletePlayer(w http.ResponseWriter, r *http.Request, id int64) {
+func (_ Unimplemented) DeletePlayer(w http.ResponseWriter, r *http.Request, id int64, params DeletePlayerParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
 // Get player by ID
 // (GET /players/{id})
-func (_ Unimplemented) GetPlayer(w http.ResponseWriter, r *http.Request, id int64) {
+func (_ Unimplemented) GetPlayer(w http.ResponseWriter, r *http.Request, id int64, params GetPlayerParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Partially update player by ID
+// (PATCH /players/{id})
+func (_ Unimplemented) PatchPlayer(w http.ResponseWriter, r *http.Request, id int64, params PatchPlayerParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
 // Update player by ID
 // (PUT /players/{id})
-func (_ Unimplemented) UpdatePlayer(w http.ResponseWriter, r *http.Request, id int64) {
+func (_ Unimplemented) UpdatePlayer(w http.ResponseWriter, r *http.Request, id int64, params UpdatePlayerParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+// Get the change history of a player
+// (GET /players/{id}/history)
+func (_ Unimplemented) GetPlayerHistory(w http.ResponseWriter, r *http.Request, id int64, params GetPlayerHistoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// Restore a deleted player
+// (POST /players/{id}:restore)
+func (_ Unimplemented) RestorePlayer(w http.ResponseWriter, r *http.Request, id int64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-// ListPlayers operation middleware
-func (siw *ServerInterfaceWrapper) ListPlayers(w http.ResponseWriter, r *http.Request) {
+// Create, update and delete players in bulk
+// (POST /players:batch)
+func (_ Unimplemented) BatchPlayers(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	var err error
+// Get list of all teams
+// (GET /teams)
+func (_ Unimplemented) ListTeams(w http.ResponseWriter, r *http.Request, params ListTeamsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListPlayersParams
+// Create a new team
+// (POST /teams)
+func (_ Unimplemented) CreateTeam(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	// ------------- Optional query parameter "page_number" -------------
+// Delete team by ID
+// (DELETE /teams/{id})
+func (_ Unimplemented) DeleteTeam(w http.ResponseWriter, r *http.Request, id int64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get team by ID
+// (GET /teams/{id})
+func (_ Unimplemented) GetTeam(w http.ResponseWriter, r *http.Request, id int64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update team by ID
+// (PUT /teams/{id})
+func (_ Unimplemented) UpdateTeam(w http.ResponseWriter, r *http.Request, id int64) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a page of players with paging metadata
+// (GET /v2/players)
+func (_ Unimplemented) ListPlayersV2(w http.ResponseWriter, r *http.Request, params ListPlayersV2Params) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// ListGames operation middleware
+func (siw *ServerInterfaceWrapper) ListGames(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListGamesParams
+
+	// ------------- Optional query parameter "team_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_id", r.URL.Query(), &params.TeamId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page_number" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "page_number", r.URL.Query(), &params.PageNumber)
 	if err != nil {
@@ -208,7 +1061,7 @@ func (siw *ServerInterfaceWrapper) ListPlayers(w http.ResponseWriter, r *http.Re
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListPlayers(w, r, params)
+		siw.Handler.ListGames(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -218,11 +1071,17 @@ func (siw *ServerInterfaceWrapper) ListPlayers(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// CreatePlayer operation middleware
-func (siw *ServerInterfaceWrapper) CreatePlayer(w http.ResponseWriter, r *http.Request) {
+// CreateGame operation middleware
+func (siw *ServerInterfaceWrapper) CreateGame(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreatePlayer(w, r)
+		siw.Handler.CreateGame(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -232,8 +1091,8 @@ func (siw *ServerInterfaceWrapper) CreatePlayer(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// DeletePlayer operation middleware
-func (siw *ServerInterfaceWrapper) DeletePlayer(w http.ResponseWriter, r *http.Request) {
+// DeleteGame operation middleware
+func (siw *ServerInterfaceWrapper) DeleteGame(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -246,8 +1105,14 @@ func (siw *ServerInterfaceWrapper) DeletePlayer(w http.ResponseWriter, r *http.R
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeletePlayer(w, r, id)
+		siw.Handler.DeleteGame(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -257,8 +1122,8 @@ func (siw *ServerInterfaceWrapper) DeletePlayer(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// GetPlayer operation middleware
-func (siw *ServerInterfaceWrapper) GetPlayer(w http.ResponseWriter, r *http.Request) {
+// GetGame operation middleware
+func (siw *ServerInterfaceWrapper) GetGame(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -271,8 +1136,14 @@ func (siw *ServerInterfaceWrapper) GetPlayer(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPlayer(w, r, id)
+		siw.Handler.GetGame(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -282,8 +1153,8 @@ func (siw *ServerInterfaceWrapper) GetPlayer(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// UpdatePlayer operation middleware
-func (siw *ServerInterfaceWrapper) UpdatePlayer(w http.ResponseWriter, r *http.Request) {
+// UpdateGame operation middleware
+func (siw *ServerInterfaceWrapper) UpdateGame(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -296,8 +1167,14 @@ func (siw *ServerInterfaceWrapper) UpdatePlayer(w http.ResponseWriter, r *http.R
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdatePlayer(w, r, id)
+		siw.Handler.UpdateGame(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -307,338 +1184,3439 @@ func (siw *ServerInterfaceWrapper) UpdatePlayer(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// ListPlayers operation middleware
+func (siw *ServerInterfaceWrapper) ListPlayers(w http.ResponseWriter, r *http.Request) {
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	var err error
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	ctx := r.Context()
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	r = r.WithContext(ctx)
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPlayersParams
 
-type RequiredParamError struct {
-	ParamName string
-}
+	// ------------- Optional query parameter "page_number" -------------
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	err = runtime.BindQueryParameter("form", true, false, "page_number", r.URL.Query(), &params.PageNumber)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_number", Err: err})
+		return
+	}
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	// ------------- Optional query parameter "page_size" -------------
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		return
+	}
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Optional query parameter "team_id" -------------
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+	err = runtime.BindQueryParameter("form", true, false, "team_id", r.URL.Query(), &params.TeamId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_id", Err: err})
+		return
+	}
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+	// ------------- Optional query parameter "role" -------------
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	err = runtime.BindQueryParameter("form", true, false, "role", r.URL.Query(), &params.Role)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "role", Err: err})
+		return
+	}
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+	// ------------- Optional query parameter "citizenship" -------------
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+	err = runtime.BindQueryParameter("form", true, false, "citizenship", r.URL.Query(), &params.Citizenship)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "citizenship", Err: err})
+		return
+	}
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
-}
+	// ------------- Optional query parameter "min_age" -------------
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	err = runtime.BindQueryParameter("form", true, false, "min_age", r.URL.Query(), &params.MinAge)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "min_age", Err: err})
+		return
+	}
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
-}
+	// ------------- Optional query parameter "max_age" -------------
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
-}
+	err = runtime.BindQueryParameter("form", true, false, "max_age", r.URL.Query(), &params.MaxAge)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_age", Err: err})
+		return
+	}
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+	// ------------- Optional query parameter "min_height" -------------
 
-	if r == nil {
-		r = chi.NewRouter()
+	err = runtime.BindQueryParameter("form", true, false, "min_height", r.URL.Query(), &params.MinHeight)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "min_height", Err: err})
+		return
 	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
+
+	// ------------- Optional query parameter "max_height" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_height", r.URL.Query(), &params.MaxHeight)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_height", Err: err})
+		return
 	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+
+	// ------------- Optional query parameter "min_weight" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min_weight", r.URL.Query(), &params.MinWeight)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "min_weight", Err: err})
+		return
 	}
 
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/players", wrapper.ListPlayers)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/players", wrapper.CreatePlayer)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/players/{id}", wrapper.DeletePlayer)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/players/{id}", wrapper.GetPlayer)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/players/{id}", wrapper.UpdatePlayer)
-	})
+	// ------------- Optional query parameter "max_weight" -------------
 
-	return r
-}
+	err = runtime.BindQueryParameter("form", true, false, "max_weight", r.URL.Query(), &params.MaxWeight)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_weight", Err: err})
+		return
+	}
 
-type ListPlayersRequestObject struct {
-	Params ListPlayersParams
-}
+	// ------------- Optional query parameter "include_deleted" -------------
 
-type ListPlayersResponseObject interface {
-	VisitListPlayersResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "include_deleted", r.URL.Query(), &params.IncludeDeleted)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_deleted", Err: err})
+		return
+	}
 
-type ListPlayers200JSONResponse []Player
+	// ------------- Optional query parameter "sort" -------------
 
-func (response ListPlayers200JSONResponse) VisitListPlayersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindQueryParameter("form", false, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "cursor" -------------
 
-type ListPlayers400Response struct {
-}
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
 
-func (response ListPlayers400Response) VisitListPlayersResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPlayers(w, r, params)
+	}))
 
-type CreatePlayerRequestObject struct {
-	Body *CreatePlayerJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type CreatePlayerResponseObject interface {
-	VisitCreatePlayerResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type CreatePlayer201JSONResponse Player
+// CreatePlayer operation middleware
+func (siw *ServerInterfaceWrapper) CreatePlayer(w http.ResponseWriter, r *http.Request) {
 
-func (response CreatePlayer201JSONResponse) VisitCreatePlayerResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreatePlayerParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreatePlayer(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportPlayers operation middleware
+func (siw *ServerInterfaceWrapper) ExportPlayers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportPlayersParams
+
+	// ------------- Optional query parameter "team_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_id", r.URL.Query(), &params.TeamId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "role" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "role", r.URL.Query(), &params.Role)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "role", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "citizenship" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "citizenship", r.URL.Query(), &params.Citizenship)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "citizenship", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "min_age" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min_age", r.URL.Query(), &params.MinAge)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "min_age", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "max_age" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_age", r.URL.Query(), &params.MaxAge)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_age", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "min_height" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min_height", r.URL.Query(), &params.MinHeight)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "min_height", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "max_height" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_height", r.URL.Query(), &params.MaxHeight)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_height", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "min_weight" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min_weight", r.URL.Query(), &params.MinWeight)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "min_weight", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "max_weight" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_weight", r.URL.Query(), &params.MaxWeight)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_weight", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_deleted" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_deleted", r.URL.Query(), &params.IncludeDeleted)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_deleted", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "units" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "units", r.URL.Query(), &params.Units)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "units", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportPlayers(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportPlayers operation middleware
+func (siw *ServerInterfaceWrapper) ImportPlayers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportPlayersParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportPlayers(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SearchPlayers operation middleware
+func (siw *ServerInterfaceWrapper) SearchPlayers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchPlayersParams
+
+	// ------------- Required query parameter "q" -------------
+
+	if paramValue := r.URL.Query().Get("q"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "q"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SearchPlayers(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeletePlayer operation middleware
+func (siw *ServerInterfaceWrapper) DeletePlayer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeletePlayerParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeletePlayer(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPlayer operation middleware
+func (siw *ServerInterfaceWrapper) GetPlayer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPlayerParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPlayer(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchPlayer operation middleware
+func (siw *ServerInterfaceWrapper) PatchPlayer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchPlayerParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchPlayer(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdatePlayer operation middleware
+func (siw *ServerInterfaceWrapper) UpdatePlayer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdatePlayerParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdatePlayer(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPlayerHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetPlayerHistory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPlayerHistoryParams
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPlayerHistory(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RestorePlayer operation middleware
+func (siw *ServerInterfaceWrapper) RestorePlayer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestorePlayer(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// BatchPlayers operation middleware
+func (siw *ServerInterfaceWrapper) BatchPlayers(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.BatchPlayers(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTeams operation middleware
+func (siw *ServerInterfaceWrapper) ListTeams(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTeamsParams
+
+	// ------------- Optional query parameter "page_number" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_number", r.URL.Query(), &params.PageNumber)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_number", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTeams(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateTeam operation middleware
+func (siw *ServerInterfaceWrapper) CreateTeam(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTeam(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteTeam operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTeam(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTeam(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeam operation middleware
+func (siw *ServerInterfaceWrapper) GetTeam(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeam(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateTeam operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTeam(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateTeam(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListPlayersV2 operation middleware
+func (siw *ServerInterfaceWrapper) ListPlayersV2(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPlayersV2Params
+
+	// ------------- Optional query parameter "page_number" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_number", r.URL.Query(), &params.PageNumber)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_number", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "team_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_id", r.URL.Query(), &params.TeamId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "role" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "role", r.URL.Query(), &params.Role)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "role", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "citizenship" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "citizenship", r.URL.Query(), &params.Citizenship)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "citizenship", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "min_age" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min_age", r.URL.Query(), &params.MinAge)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "min_age", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "max_age" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_age", r.URL.Query(), &params.MaxAge)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_age", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "min_height" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min_height", r.URL.Query(), &params.MinHeight)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "min_height", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "max_height" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_height", r.URL.Query(), &params.MaxHeight)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_height", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "min_weight" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min_weight", r.URL.Query(), &params.MinWeight)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "min_weight", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "max_weight" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_weight", r.URL.Query(), &params.MaxWeight)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_weight", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_deleted" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_deleted", r.URL.Query(), &params.IncludeDeleted)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_deleted", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPlayersV2(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/games", wrapper.ListGames)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/games", wrapper.CreateGame)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/games/{id}", wrapper.DeleteGame)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/games/{id}", wrapper.GetGame)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/games/{id}", wrapper.UpdateGame)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/players", wrapper.ListPlayers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/players", wrapper.CreatePlayer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/players/export", wrapper.ExportPlayers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/players/import", wrapper.ImportPlayers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/players/search", wrapper.SearchPlayers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/players/{id}", wrapper.DeletePlayer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/players/{id}", wrapper.GetPlayer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/players/{id}", wrapper.PatchPlayer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/players/{id}", wrapper.UpdatePlayer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/players/{id}/history", wrapper.GetPlayerHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/players/{id}:restore", wrapper.RestorePlayer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/players:batch", wrapper.BatchPlayers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/teams", wrapper.ListTeams)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/teams", wrapper.CreateTeam)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/teams/{id}", wrapper.DeleteTeam)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/teams/{id}", wrapper.GetTeam)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/teams/{id}", wrapper.UpdateTeam)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/players", wrapper.ListPlayersV2)
+	})
+
+	return r
+}
+
+type BadRequestApplicationProblemPlusJSONResponse Problem
+
+type ConflictApplicationProblemPlusJSONResponse Problem
+
+type ContentTooLargeApplicationProblemPlusJSONResponse Problem
+
+type ForbiddenApplicationProblemPlusJSONResponse Problem
+
+type NotAcceptableApplicationProblemPlusJSONResponse Problem
+
+type NotFoundApplicationProblemPlusJSONResponse Problem
+
+type PreconditionFailedApplicationProblemPlusJSONResponse Problem
+
+type PreconditionRequiredApplicationProblemPlusJSONResponse Problem
+
+type ServiceUnavailableApplicationProblemPlusJSONResponse Problem
+
+type UnauthorizedResponseHeaders struct {
+	WWWAuthenticate string
+}
+type UnauthorizedApplicationProblemPlusJSONResponse struct {
+	Body Problem
+
+	Headers UnauthorizedResponseHeaders
+}
+
+type UnprocessableContentApplicationProblemPlusJSONResponse Problem
+
+type UnsupportedMediaTypeApplicationProblemPlusJSONResponse Problem
+
+type ListGamesRequestObject struct {
+	Params ListGamesParams
+}
+
+type ListGamesResponseObject interface {
+	VisitListGamesResponse(w http.ResponseWriter) error
+}
+
+type ListGames200JSONResponse []Game
+
+func (response ListGames200JSONResponse) VisitListGamesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGames400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response ListGames400ApplicationProblemPlusJSONResponse) VisitListGamesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGames401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response ListGames401ApplicationProblemPlusJSONResponse) VisitListGamesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListGames403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response ListGames403ApplicationProblemPlusJSONResponse) VisitListGamesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateGameRequestObject struct {
+	Body *CreateGameJSONRequestBody
+}
+
+type CreateGameResponseObject interface {
+	VisitCreateGameResponse(w http.ResponseWriter) error
+}
+
+type CreateGame201JSONResponse Game
+
+func (response CreateGame201JSONResponse) VisitCreateGameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateGame400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response CreateGame400ApplicationProblemPlusJSONResponse) VisitCreateGameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateGame401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response CreateGame401ApplicationProblemPlusJSONResponse) VisitCreateGameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateGame403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response CreateGame403ApplicationProblemPlusJSONResponse) VisitCreateGameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateGame409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response CreateGame409ApplicationProblemPlusJSONResponse) VisitCreateGameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteGameRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type DeleteGameResponseObject interface {
+	VisitDeleteGameResponse(w http.ResponseWriter) error
+}
+
+type DeleteGame204Response struct {
+}
+
+func (response DeleteGame204Response) VisitDeleteGameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteGame401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteGame401ApplicationProblemPlusJSONResponse) VisitDeleteGameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteGame403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteGame403ApplicationProblemPlusJSONResponse) VisitDeleteGameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteGame404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteGame404ApplicationProblemPlusJSONResponse) VisitDeleteGameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGameRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type GetGameResponseObject interface {
+	VisitGetGameResponse(w http.ResponseWriter) error
+}
+
+type GetGame200JSONResponse Game
+
+func (response GetGame200JSONResponse) VisitGetGameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGame401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetGame401ApplicationProblemPlusJSONResponse) VisitGetGameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetGame403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetGame403ApplicationProblemPlusJSONResponse) VisitGetGameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGame404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetGame404ApplicationProblemPlusJSONResponse) VisitGetGameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateGameRequestObject struct {
+	Id   int64 `json:"id"`
+	Body *UpdateGameJSONRequestBody
+}
+
+type UpdateGameResponseObject interface {
+	VisitUpdateGameResponse(w http.ResponseWriter) error
+}
+
+type UpdateGame200JSONResponse Game
+
+func (response UpdateGame200JSONResponse) VisitUpdateGameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateGame400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateGame400ApplicationProblemPlusJSONResponse) VisitUpdateGameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateGame401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateGame401ApplicationProblemPlusJSONResponse) VisitUpdateGameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateGame403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateGame403ApplicationProblemPlusJSONResponse) VisitUpdateGameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateGame404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateGame404ApplicationProblemPlusJSONResponse) VisitUpdateGameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateGame409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateGame409ApplicationProblemPlusJSONResponse) VisitUpdateGameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPlayersRequestObject struct {
+	Params ListPlayersParams
+}
+
+type ListPlayersResponseObject interface {
+	VisitListPlayersResponse(w http.ResponseWriter) error
+}
+
+type ListPlayers200ResponseHeaders struct {
+	Link string
+}
+
+type ListPlayers200JSONResponse struct {
+	Body    []Player
+	Headers ListPlayers200ResponseHeaders
+}
+
+func (response ListPlayers200JSONResponse) VisitListPlayersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Link", fmt.Sprint(response.Headers.Link))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListPlayers400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response ListPlayers400ApplicationProblemPlusJSONResponse) VisitListPlayersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPlayers401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response ListPlayers401ApplicationProblemPlusJSONResponse) VisitListPlayersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListPlayers403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response ListPlayers403ApplicationProblemPlusJSONResponse) VisitListPlayersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePlayerRequestObject struct {
+	Params CreatePlayerParams
+	Body   *CreatePlayerJSONRequestBody
+}
+
+type CreatePlayerResponseObject interface {
+	VisitCreatePlayerResponse(w http.ResponseWriter) error
+}
+
+type CreatePlayer201JSONResponse Player
+
+func (response CreatePlayer201JSONResponse) VisitCreatePlayerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePlayer400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response CreatePlayer400ApplicationProblemPlusJSONResponse) VisitCreatePlayerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePlayer401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response CreatePlayer401ApplicationProblemPlusJSONResponse) VisitCreatePlayerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreatePlayer403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response CreatePlayer403ApplicationProblemPlusJSONResponse) VisitCreatePlayerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePlayer409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response CreatePlayer409ApplicationProblemPlusJSONResponse) VisitCreatePlayerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePlayer422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response CreatePlayer422ApplicationProblemPlusJSONResponse) VisitCreatePlayerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportPlayersRequestObject struct {
+	Params ExportPlayersParams
+}
+
+type ExportPlayersResponseObject interface {
+	VisitExportPlayersResponse(w http.ResponseWriter) error
+}
+
+type ExportPlayers200ResponseHeaders struct {
+	ContentDisposition string
+}
+
+type ExportPlayers200ApplicationvndOpenxmlformatsOfficedocumentSpreadsheetmlSheetResponse struct {
+	Body          io.Reader
+	Headers       ExportPlayers200ResponseHeaders
+	ContentLength int64
+}
+
+func (response ExportPlayers200ApplicationvndOpenxmlformatsOfficedocumentSpreadsheetmlSheetResponse) VisitExportPlayersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportPlayers200ApplicationxNdjsonResponse struct {
+	Body          io.Reader
+	Headers       ExportPlayers200ResponseHeaders
+	ContentLength int64
+}
+
+func (response ExportPlayers200ApplicationxNdjsonResponse) VisitExportPlayersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportPlayers200TextcsvResponse struct {
+	Body          io.Reader
+	Headers       ExportPlayers200ResponseHeaders
+	ContentLength int64
+}
+
+func (response ExportPlayers200TextcsvResponse) VisitExportPlayersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportPlayers400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response ExportPlayers400ApplicationProblemPlusJSONResponse) VisitExportPlayersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportPlayers401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response ExportPlayers401ApplicationProblemPlusJSONResponse) VisitExportPlayersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ExportPlayers403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response ExportPlayers403ApplicationProblemPlusJSONResponse) VisitExportPlayersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportPlayers406ApplicationProblemPlusJSONResponse struct {
+	NotAcceptableApplicationProblemPlusJSONResponse
+}
+
+func (response ExportPlayers406ApplicationProblemPlusJSONResponse) VisitExportPlayersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(406)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportPlayers503ApplicationProblemPlusJSONResponse struct {
+	ServiceUnavailableApplicationProblemPlusJSONResponse
+}
+
+func (response ExportPlayers503ApplicationProblemPlusJSONResponse) VisitExportPlayersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportPlayersRequestObject struct {
+	Params      ImportPlayersParams
+	ContentType string
+	Body        io.Reader
+}
+
+type ImportPlayersResponseObject interface {
+	VisitImportPlayersResponse(w http.ResponseWriter) error
+}
+
+type ImportPlayers200JSONResponse PlayerImportReport
+
+func (response ImportPlayers200JSONResponse) VisitImportPlayersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportPlayers400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response ImportPlayers400ApplicationProblemPlusJSONResponse) VisitImportPlayersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportPlayers401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response ImportPlayers401ApplicationProblemPlusJSONResponse) VisitImportPlayersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ImportPlayers403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response ImportPlayers403ApplicationProblemPlusJSONResponse) VisitImportPlayersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportPlayers409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response ImportPlayers409ApplicationProblemPlusJSONResponse) VisitImportPlayersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportPlayers413ApplicationProblemPlusJSONResponse struct {
+	ContentTooLargeApplicationProblemPlusJSONResponse
+}
+
+func (response ImportPlayers413ApplicationProblemPlusJSONResponse) VisitImportPlayersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportPlayers415ApplicationProblemPlusJSONResponse struct {
+	UnsupportedMediaTypeApplicationProblemPlusJSONResponse
+}
+
+func (response ImportPlayers415ApplicationProblemPlusJSONResponse) VisitImportPlayersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(415)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportPlayers422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response ImportPlayers422ApplicationProblemPlusJSONResponse) VisitImportPlayersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchPlayersRequestObject struct {
+	Params SearchPlayersParams
+}
+
+type SearchPlayersResponseObject interface {
+	VisitSearchPlayersResponse(w http.ResponseWriter) error
+}
+
+type SearchPlayers200JSONResponse []PlayerSearchResult
+
+func (response SearchPlayers200JSONResponse) VisitSearchPlayersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchPlayers400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response SearchPlayers400ApplicationProblemPlusJSONResponse) VisitSearchPlayersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchPlayers401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response SearchPlayers401ApplicationProblemPlusJSONResponse) VisitSearchPlayersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SearchPlayers403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response SearchPlayers403ApplicationProblemPlusJSONResponse) VisitSearchPlayersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePlayerRequestObject struct {
+	Id     int64 `json:"id"`
+	Params DeletePlayerParams
+}
+
+type DeletePlayerResponseObject interface {
+	VisitDeletePlayerResponse(w http.ResponseWriter) error
+}
+
+type DeletePlayer204Response struct {
+}
+
+func (response DeletePlayer204Response) VisitDeletePlayerResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeletePlayer401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response DeletePlayer401ApplicationProblemPlusJSONResponse) VisitDeletePlayerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeletePlayer403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response DeletePlayer403ApplicationProblemPlusJSONResponse) VisitDeletePlayerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePlayer404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response DeletePlayer404ApplicationProblemPlusJSONResponse) VisitDeletePlayerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePlayer412ApplicationProblemPlusJSONResponse struct {
+	PreconditionFailedApplicationProblemPlusJSONResponse
+}
+
+func (response DeletePlayer412ApplicationProblemPlusJSONResponse) VisitDeletePlayerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePlayer428ApplicationProblemPlusJSONResponse struct {
+	PreconditionRequiredApplicationProblemPlusJSONResponse
+}
+
+func (response DeletePlayer428ApplicationProblemPlusJSONResponse) VisitDeletePlayerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(428)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlayerRequestObject struct {
+	Id     int64 `json:"id"`
+	Params GetPlayerParams
+}
+
+type GetPlayerResponseObject interface {
+	VisitGetPlayerResponse(w http.ResponseWriter) error
+}
+
+type GetPlayer200ResponseHeaders struct {
+	ETag string
+}
+
+type GetPlayer200JSONResponse struct {
+	Body    Player
+	Headers GetPlayer200ResponseHeaders
+}
+
+func (response GetPlayer200JSONResponse) VisitGetPlayerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetPlayer304ResponseHeaders struct {
+	ETag string
+}
+
+type GetPlayer304Response struct {
+	Headers GetPlayer304ResponseHeaders
+}
+
+func (response GetPlayer304Response) VisitGetPlayerResponse(w http.ResponseWriter) error {
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(304)
+	return nil
+}
+
+type GetPlayer401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetPlayer401ApplicationProblemPlusJSONResponse) VisitGetPlayerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetPlayer403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetPlayer403ApplicationProblemPlusJSONResponse) VisitGetPlayerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlayer404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetPlayer404ApplicationProblemPlusJSONResponse) VisitGetPlayerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchPlayerRequestObject struct {
+	Id     int64 `json:"id"`
+	Params PatchPlayerParams
+	Body   *PatchPlayerApplicationMergePatchPlusJSONRequestBody
+}
+
+type PatchPlayerResponseObject interface {
+	VisitPatchPlayerResponse(w http.ResponseWriter) error
+}
+
+type PatchPlayer200ResponseHeaders struct {
+	ETag string
+}
+
+type PatchPlayer200JSONResponse struct {
+	Body    Player
+	Headers PatchPlayer200ResponseHeaders
+}
+
+func (response PatchPlayer200JSONResponse) VisitPatchPlayerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PatchPlayer400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PatchPlayer400ApplicationProblemPlusJSONResponse) VisitPatchPlayerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchPlayer401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PatchPlayer401ApplicationProblemPlusJSONResponse) VisitPatchPlayerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PatchPlayer403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PatchPlayer403ApplicationProblemPlusJSONResponse) VisitPatchPlayerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchPlayer404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response PatchPlayer404ApplicationProblemPlusJSONResponse) VisitPatchPlayerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchPlayer409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response PatchPlayer409ApplicationProblemPlusJSONResponse) VisitPatchPlayerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchPlayer412ApplicationProblemPlusJSONResponse struct {
+	PreconditionFailedApplicationProblemPlusJSONResponse
+}
+
+func (response PatchPlayer412ApplicationProblemPlusJSONResponse) VisitPatchPlayerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchPlayer428ApplicationProblemPlusJSONResponse struct {
+	PreconditionRequiredApplicationProblemPlusJSONResponse
+}
+
+func (response PatchPlayer428ApplicationProblemPlusJSONResponse) VisitPatchPlayerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(428)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePlayerRequestObject struct {
+	Id     int64 `json:"id"`
+	Params UpdatePlayerParams
+	Body   *UpdatePlayerJSONRequestBody
+}
+
+type UpdatePlayerResponseObject interface {
+	VisitUpdatePlayerResponse(w http.ResponseWriter) error
+}
+
+type UpdatePlayer200ResponseHeaders struct {
+	ETag string
+}
+
+type UpdatePlayer200JSONResponse struct {
+	Body    Player
+	Headers UpdatePlayer200ResponseHeaders
+}
+
+func (response UpdatePlayer200JSONResponse) VisitUpdatePlayerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdatePlayer400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response UpdatePlayer400ApplicationProblemPlusJSONResponse) VisitUpdatePlayerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePlayer401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response UpdatePlayer401ApplicationProblemPlusJSONResponse) VisitUpdatePlayerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdatePlayer403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response UpdatePlayer403ApplicationProblemPlusJSONResponse) VisitUpdatePlayerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePlayer404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response UpdatePlayer404ApplicationProblemPlusJSONResponse) VisitUpdatePlayerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePlayer409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response UpdatePlayer409ApplicationProblemPlusJSONResponse) VisitUpdatePlayerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePlayer412ApplicationProblemPlusJSONResponse struct {
+	PreconditionFailedApplicationProblemPlusJSONResponse
+}
+
+func (response UpdatePlayer412ApplicationProblemPlusJSONResponse) VisitUpdatePlayerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePlayer428ApplicationProblemPlusJSONResponse struct {
+	PreconditionRequiredApplicationProblemPlusJSONResponse
+}
+
+func (response UpdatePlayer428ApplicationProblemPlusJSONResponse) VisitUpdatePlayerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(428)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlayerHistoryRequestObject struct {
+	Id     int64 `json:"id"`
+	Params GetPlayerHistoryParams
+}
+
+type GetPlayerHistoryResponseObject interface {
+	VisitGetPlayerHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetPlayerHistory200JSONResponse PlayerHistory
+
+func (response GetPlayerHistory200JSONResponse) VisitGetPlayerHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlayerHistory400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetPlayerHistory400ApplicationProblemPlusJSONResponse) VisitGetPlayerHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlayerHistory401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetPlayerHistory401ApplicationProblemPlusJSONResponse) VisitGetPlayerHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetPlayerHistory403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetPlayerHistory403ApplicationProblemPlusJSONResponse) VisitGetPlayerHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlayerHistory404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetPlayerHistory404ApplicationProblemPlusJSONResponse) VisitGetPlayerHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestorePlayerRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type RestorePlayerResponseObject interface {
+	VisitRestorePlayerResponse(w http.ResponseWriter) error
+}
+
+type RestorePlayer200JSONResponse Player
+
+func (response RestorePlayer200JSONResponse) VisitRestorePlayerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestorePlayer401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response RestorePlayer401ApplicationProblemPlusJSONResponse) VisitRestorePlayerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RestorePlayer403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response RestorePlayer403ApplicationProblemPlusJSONResponse) VisitRestorePlayerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestorePlayer404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response RestorePlayer404ApplicationProblemPlusJSONResponse) VisitRestorePlayerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestorePlayer409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response RestorePlayer409ApplicationProblemPlusJSONResponse) VisitRestorePlayerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchPlayersRequestObject struct {
+	Body *BatchPlayersJSONRequestBody
+}
+
+type BatchPlayersResponseObject interface {
+	VisitBatchPlayersResponse(w http.ResponseWriter) error
+}
+
+type BatchPlayers200JSONResponse PlayerBatchResponse
+
+func (response BatchPlayers200JSONResponse) VisitBatchPlayersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchPlayers400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response BatchPlayers400ApplicationProblemPlusJSONResponse) VisitBatchPlayersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchPlayers401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response BatchPlayers401ApplicationProblemPlusJSONResponse) VisitBatchPlayersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type BatchPlayers403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response BatchPlayers403ApplicationProblemPlusJSONResponse) VisitBatchPlayersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BatchPlayers422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableContentApplicationProblemPlusJSONResponse
+}
+
+func (response BatchPlayers422ApplicationProblemPlusJSONResponse) VisitBatchPlayersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTeamsRequestObject struct {
+	Params ListTeamsParams
+}
+
+type ListTeamsResponseObject interface {
+	VisitListTeamsResponse(w http.ResponseWriter) error
+}
+
+type ListTeams200JSONResponse []Team
+
+func (response ListTeams200JSONResponse) VisitListTeamsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTeams400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response ListTeams400ApplicationProblemPlusJSONResponse) VisitListTeamsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTeams401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response ListTeams401ApplicationProblemPlusJSONResponse) VisitListTeamsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListTeams403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response ListTeams403ApplicationProblemPlusJSONResponse) VisitListTeamsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTeamRequestObject struct {
+	Body *CreateTeamJSONRequestBody
+}
+
+type CreateTeamResponseObject interface {
+	VisitCreateTeamResponse(w http.ResponseWriter) error
+}
+
+type CreateTeam201JSONResponse Team
+
+func (response CreateTeam201JSONResponse) VisitCreateTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(201)
 
-	return json.NewEncoder(w).Encode(response)
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTeam400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response CreateTeam400ApplicationProblemPlusJSONResponse) VisitCreateTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTeam401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response CreateTeam401ApplicationProblemPlusJSONResponse) VisitCreateTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateTeam403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response CreateTeam403ApplicationProblemPlusJSONResponse) VisitCreateTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTeam409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response CreateTeam409ApplicationProblemPlusJSONResponse) VisitCreateTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTeamRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type DeleteTeamResponseObject interface {
+	VisitDeleteTeamResponse(w http.ResponseWriter) error
+}
+
+type DeleteTeam204Response struct {
+}
+
+func (response DeleteTeam204Response) VisitDeleteTeamResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteTeam401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteTeam401ApplicationProblemPlusJSONResponse) VisitDeleteTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteTeam403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteTeam403ApplicationProblemPlusJSONResponse) VisitDeleteTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTeam404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteTeam404ApplicationProblemPlusJSONResponse) VisitDeleteTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTeam409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteTeam409ApplicationProblemPlusJSONResponse) VisitDeleteTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamRequestObject struct {
+	Id int64 `json:"id"`
+}
+
+type GetTeamResponseObject interface {
+	VisitGetTeamResponse(w http.ResponseWriter) error
+}
+
+type GetTeam200JSONResponse Team
+
+func (response GetTeam200JSONResponse) VisitGetTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeam401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetTeam401ApplicationProblemPlusJSONResponse) VisitGetTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTeam403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetTeam403ApplicationProblemPlusJSONResponse) VisitGetTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeam404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetTeam404ApplicationProblemPlusJSONResponse) VisitGetTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTeamRequestObject struct {
+	Id   int64 `json:"id"`
+	Body *UpdateTeamJSONRequestBody
+}
+
+type UpdateTeamResponseObject interface {
+	VisitUpdateTeamResponse(w http.ResponseWriter) error
+}
+
+type UpdateTeam200JSONResponse Team
+
+func (response UpdateTeam200JSONResponse) VisitUpdateTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTeam400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateTeam400ApplicationProblemPlusJSONResponse) VisitUpdateTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTeam401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateTeam401ApplicationProblemPlusJSONResponse) VisitUpdateTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateTeam403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateTeam403ApplicationProblemPlusJSONResponse) VisitUpdateTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTeam404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateTeam404ApplicationProblemPlusJSONResponse) VisitUpdateTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTeam409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateTeam409ApplicationProblemPlusJSONResponse) VisitUpdateTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPlayersV2RequestObject struct {
+	Params ListPlayersV2Params
+}
+
+type ListPlayersV2ResponseObject interface {
+	VisitListPlayersV2Response(w http.ResponseWriter) error
+}
+
+type ListPlayersV2200ResponseHeaders struct {
+	Link string
+}
+
+type ListPlayersV2200JSONResponse struct {
+	Body    PlayerList
+	Headers ListPlayersV2200ResponseHeaders
+}
+
+func (response ListPlayersV2200JSONResponse) VisitListPlayersV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Link", fmt.Sprint(response.Headers.Link))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListPlayersV2400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response ListPlayersV2400ApplicationProblemPlusJSONResponse) VisitListPlayersV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPlayersV2401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response ListPlayersV2401ApplicationProblemPlusJSONResponse) VisitListPlayersV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListPlayersV2403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response ListPlayersV2403ApplicationProblemPlusJSONResponse) VisitListPlayersV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Get the schedule of games
+	// (GET /games)
+	ListGames(ctx context.Context, request ListGamesRequestObject) (ListGamesResponseObject, error)
+	// Schedule a new game
+	// (POST /games)
+	CreateGame(ctx context.Context, request CreateGameRequestObject) (CreateGameResponseObject, error)
+	// Delete game by ID
+	// (DELETE /games/{id})
+	DeleteGame(ctx context.Context, request DeleteGameRequestObject) (DeleteGameResponseObject, error)
+	// Get game by ID
+	// (GET /games/{id})
+	GetGame(ctx context.Context, request GetGameRequestObject) (GetGameResponseObject, error)
+	// Update game by ID
+	// (PUT /games/{id})
+	UpdateGame(ctx context.Context, request UpdateGameRequestObject) (UpdateGameResponseObject, error)
+	// Get list of all players
+	// (GET /players)
+	ListPlayers(ctx context.Context, request ListPlayersRequestObject) (ListPlayersResponseObject, error)
+	// Create a new player
+	// (POST /players)
+	CreatePlayer(ctx context.Context, request CreatePlayerRequestObject) (CreatePlayerResponseObject, error)
+	// Export players as CSV, NDJSON or XLSX
+	// (GET /players/export)
+	ExportPlayers(ctx context.Context, request ExportPlayersRequestObject) (ExportPlayersResponseObject, error)
+	// Import players from CSV or NDJSON
+	// (POST /players/import)
+	ImportPlayers(ctx context.Context, request ImportPlayersRequestObject) (ImportPlayersResponseObject, error)
+	// Search players by name
+	// (GET /players/search)
+	SearchPlayers(ctx context.Context, request SearchPlayersRequestObject) (SearchPlayersResponseObject, error)
+	// Delete player by ID
+	// (DELETE /players/{id})
+	DeletePlayer(ctx context.Context, request DeletePlayerRequestObject) (DeletePlayerResponseObject, error)
+	// Get player by ID
+	// (GET /players/{id})
+	GetPlayer(ctx context.Context, request GetPlayerRequestObject) (GetPlayerResponseObject, error)
+	// Partially update player by ID
+	// (PATCH /players/{id})
+	PatchPlayer(ctx context.Context, request PatchPlayerRequestObject) (PatchPlayerResponseObject, error)
+	// Update player by ID
+	// (PUT /players/{id})
+	UpdatePlayer(ctx context.Context, request UpdatePlayerRequestObject) (UpdatePlayerResponseObject, error)
+	// Get the change history of a player
+	// (GET /players/{id}/history)
+	GetPlayerHistory(ctx context.Context, request GetPlayerHistoryRequestObject) (GetPlayerHistoryResponseObject, error)
+	// Restore a deleted player
+	// (POST /players/{id}:restore)
+	RestorePlayer(ctx context.Context, request RestorePlayerRequestObject) (RestorePlayerResponseObject, error)
+	// Create, update and delete players in bulk
+	// (POST /players:batch)
+	BatchPlayers(ctx context.Context, request BatchPlayersRequestObject) (BatchPlayersResponseObject, error)
+	// Get list of all teams
+	// (GET /teams)
+	ListTeams(ctx context.Context, request ListTeamsRequestObject) (ListTeamsResponseObject, error)
+	// Create a new team
+	// (POST /teams)
+	CreateTeam(ctx context.Context, request CreateTeamRequestObject) (CreateTeamResponseObject, error)
+	// Delete team by ID
+	// (DELETE /teams/{id})
+	DeleteTeam(ctx context.Context, request DeleteTeamRequestObject) (DeleteTeamResponseObject, error)
+	// Get team by ID
+	// (GET /teams/{id})
+	GetTeam(ctx context.Context, request GetTeamRequestObject) (GetTeamResponseObject, error)
+	// Update team by ID
+	// (PUT /teams/{id})
+	UpdateTeam(ctx context.Context, request UpdateTeamRequestObject) (UpdateTeamResponseObject, error)
+	// Get a page of players with paging metadata
+	// (GET /v2/players)
+	ListPlayersV2(ctx context.Context, request ListPlayersV2RequestObject) (ListPlayersV2ResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// ListGames operation middleware
+func (sh *strictHandler) ListGames(w http.ResponseWriter, r *http.Request, params ListGamesParams) {
+	var request ListGamesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListGames(ctx, request.(ListGamesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListGames")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListGamesResponseObject); ok {
+		if err := validResponse.VisitListGamesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateGame operation middleware
+func (sh *strictHandler) CreateGame(w http.ResponseWriter, r *http.Request) {
+	var request CreateGameRequestObject
+
+	var body CreateGameJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateGame(ctx, request.(CreateGameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateGame")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateGameResponseObject); ok {
+		if err := validResponse.VisitCreateGameResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteGame operation middleware
+func (sh *strictHandler) DeleteGame(w http.ResponseWriter, r *http.Request, id int64) {
+	var request DeleteGameRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteGame(ctx, request.(DeleteGameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteGame")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteGameResponseObject); ok {
+		if err := validResponse.VisitDeleteGameResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetGame operation middleware
+func (sh *strictHandler) GetGame(w http.ResponseWriter, r *http.Request, id int64) {
+	var request GetGameRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetGame(ctx, request.(GetGameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetGame")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetGameResponseObject); ok {
+		if err := validResponse.VisitGetGameResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateGame operation middleware
+func (sh *strictHandler) UpdateGame(w http.ResponseWriter, r *http.Request, id int64) {
+	var request UpdateGameRequestObject
+
+	request.Id = id
+
+	var body UpdateGameJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateGame(ctx, request.(UpdateGameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateGame")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateGameResponseObject); ok {
+		if err := validResponse.VisitUpdateGameResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListPlayers operation middleware
+func (sh *strictHandler) ListPlayers(w http.ResponseWriter, r *http.Request, params ListPlayersParams) {
+	var request ListPlayersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListPlayers(ctx, request.(ListPlayersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListPlayers")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListPlayersResponseObject); ok {
+		if err := validResponse.VisitListPlayersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreatePlayer operation middleware
+func (sh *strictHandler) CreatePlayer(w http.ResponseWriter, r *http.Request, params CreatePlayerParams) {
+	var request CreatePlayerRequestObject
+
+	request.Params = params
+
+	var body CreatePlayerJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreatePlayer(ctx, request.(CreatePlayerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreatePlayer")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreatePlayerResponseObject); ok {
+		if err := validResponse.VisitCreatePlayerResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ExportPlayers operation middleware
+func (sh *strictHandler) ExportPlayers(w http.ResponseWriter, r *http.Request, params ExportPlayersParams) {
+	var request ExportPlayersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ExportPlayers(ctx, request.(ExportPlayersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExportPlayers")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ExportPlayersResponseObject); ok {
+		if err := validResponse.VisitExportPlayersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ImportPlayers operation middleware
+func (sh *strictHandler) ImportPlayers(w http.ResponseWriter, r *http.Request, params ImportPlayersParams) {
+	var request ImportPlayersRequestObject
+
+	request.Params = params
+	request.ContentType = r.Header.Get("Content-Type")
+
+	request.Body = r.Body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ImportPlayers(ctx, request.(ImportPlayersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ImportPlayers")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ImportPlayersResponseObject); ok {
+		if err := validResponse.VisitImportPlayersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SearchPlayers operation middleware
+func (sh *strictHandler) SearchPlayers(w http.ResponseWriter, r *http.Request, params SearchPlayersParams) {
+	var request SearchPlayersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SearchPlayers(ctx, request.(SearchPlayersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SearchPlayers")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SearchPlayersResponseObject); ok {
+		if err := validResponse.VisitSearchPlayersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeletePlayer operation middleware
+func (sh *strictHandler) DeletePlayer(w http.ResponseWriter, r *http.Request, id int64, params DeletePlayerParams) {
+	var request DeletePlayerRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeletePlayer(ctx, request.(DeletePlayerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeletePlayer")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeletePlayerResponseObject); ok {
+		if err := validResponse.VisitDeletePlayerResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreatePlayer400Response struct {
-}
+// GetPlayer operation middleware
+func (sh *strictHandler) GetPlayer(w http.ResponseWriter, r *http.Request, id int64, params GetPlayerParams) {
+	var request GetPlayerRequestObject
 
-func (response CreatePlayer400Response) VisitCreatePlayerResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
-}
+	request.Id = id
+	request.Params = params
 
-type DeletePlayerRequestObject struct {
-	Id int64 `json:"id"`
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPlayer(ctx, request.(GetPlayerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPlayer")
+	}
 
-type DeletePlayerResponseObject interface {
-	VisitDeletePlayerResponse(w http.ResponseWriter) error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type DeletePlayer204Response struct {
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPlayerResponseObject); ok {
+		if err := validResponse.VisitGetPlayerResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response DeletePlayer204Response) VisitDeletePlayerResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+// PatchPlayer operation middleware
+func (sh *strictHandler) PatchPlayer(w http.ResponseWriter, r *http.Request, id int64, params PatchPlayerParams) {
+	var request PatchPlayerRequestObject
 
-type DeletePlayer404Response struct {
-}
+	request.Id = id
+	request.Params = params
 
-func (response DeletePlayer404Response) VisitDeletePlayerResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	var body PatchPlayerApplicationMergePatchPlusJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetPlayerRequestObject struct {
-	Id int64 `json:"id"`
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchPlayer(ctx, request.(PatchPlayerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchPlayer")
+	}
 
-type GetPlayerResponseObject interface {
-	VisitGetPlayerResponse(w http.ResponseWriter) error
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchPlayerResponseObject); ok {
+		if err := validResponse.VisitPatchPlayerResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetPlayer200JSONResponse Player
+// UpdatePlayer operation middleware
+func (sh *strictHandler) UpdatePlayer(w http.ResponseWriter, r *http.Request, id int64, params UpdatePlayerParams) {
+	var request UpdatePlayerRequestObject
 
-func (response GetPlayer200JSONResponse) VisitGetPlayerResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Id = id
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body UpdatePlayerJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetPlayer404Response struct {
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdatePlayer(ctx, request.(UpdatePlayerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdatePlayer")
+	}
 
-func (response GetPlayer404Response) VisitGetPlayerResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type UpdatePlayerRequestObject struct {
-	Id   int64 `json:"id"`
-	Body *UpdatePlayerJSONRequestBody
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdatePlayerResponseObject); ok {
+		if err := validResponse.VisitUpdatePlayerResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdatePlayerResponseObject interface {
-	VisitUpdatePlayerResponse(w http.ResponseWriter) error
-}
+// GetPlayerHistory operation middleware
+func (sh *strictHandler) GetPlayerHistory(w http.ResponseWriter, r *http.Request, id int64, params GetPlayerHistoryParams) {
+	var request GetPlayerHistoryRequestObject
 
-type UpdatePlayer200JSONResponse Player
+	request.Id = id
+	request.Params = params
 
-func (response UpdatePlayer200JSONResponse) VisitUpdatePlayerResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPlayerHistory(ctx, request.(GetPlayerHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPlayerHistory")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type UpdatePlayer400Response struct {
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPlayerHistoryResponseObject); ok {
+		if err := validResponse.VisitGetPlayerHistoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response UpdatePlayer400Response) VisitUpdatePlayerResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
-}
+// RestorePlayer operation middleware
+func (sh *strictHandler) RestorePlayer(w http.ResponseWriter, r *http.Request, id int64) {
+	var request RestorePlayerRequestObject
 
-type UpdatePlayer404Response struct {
-}
+	request.Id = id
 
-func (response UpdatePlayer404Response) VisitUpdatePlayerResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RestorePlayer(ctx, request.(RestorePlayerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RestorePlayer")
+	}
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Get list of all players
-	// (GET /players)
-	ListPlayers(ctx context.Context, request ListPlayersRequestObject) (ListPlayersResponseObject, error)
-	// Create a new player
-	// (POST /players)
-	CreatePlayer(ctx context.Context, request CreatePlayerRequestObject) (CreatePlayerResponseObject, error)
-	// Delete player by ID
-	// (DELETE /players/{id})
-	DeletePlayer(ctx context.Context, request DeletePlayerRequestObject) (DeletePlayerResponseObject, error)
-	// Get player by ID
-	// (GET /players/{id})
-	GetPlayer(ctx context.Context, request GetPlayerRequestObject) (GetPlayerResponseObject, error)
-	// Update player by ID
-	// (PUT /players/{id})
-	UpdatePlayer(ctx context.Context, request UpdatePlayerRequestObject) (UpdatePlayerResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RestorePlayerResponseObject); ok {
+		if err := validResponse.VisitRestorePlayerResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+// BatchPlayers operation middleware
+func (sh *strictHandler) BatchPlayers(w http.ResponseWriter, r *http.Request) {
+	var request BatchPlayersRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	var body BatchPlayersJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.BatchPlayers(ctx, request.(BatchPlayersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BatchPlayers")
+	}
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(BatchPlayersResponseObject); ok {
+		if err := validResponse.VisitBatchPlayersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// ListPlayers operation middleware
-func (sh *strictHandler) ListPlayers(w http.ResponseWriter, r *http.Request, params ListPlayersParams) {
-	var request ListPlayersRequestObject
+// ListTeams operation middleware
+func (sh *strictHandler) ListTeams(w http.ResponseWriter, r *http.Request, params ListTeamsParams) {
+	var request ListTeamsRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListPlayers(ctx, request.(ListPlayersRequestObject))
+		return sh.ssi.ListTeams(ctx, request.(ListTeamsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListPlayers")
+		handler = middleware(handler, "ListTeams")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListPlayersResponseObject); ok {
-		if err := validResponse.VisitListPlayersResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListTeamsResponseObject); ok {
+		if err := validResponse.VisitListTeamsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -646,11 +4624,11 @@ func (sh *strictHandler) ListPlayers(w http.ResponseWriter, r *http.Request, par
 	}
 }
 
-// CreatePlayer operation middleware
-func (sh *strictHandler) CreatePlayer(w http.ResponseWriter, r *http.Request) {
-	var request CreatePlayerRequestObject
+// CreateTeam operation middleware
+func (sh *strictHandler) CreateTeam(w http.ResponseWriter, r *http.Request) {
+	var request CreateTeamRequestObject
 
-	var body CreatePlayerJSONRequestBody
+	var body CreateTeamJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -658,18 +4636,18 @@ func (sh *strictHandler) CreatePlayer(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreatePlayer(ctx, request.(CreatePlayerRequestObject))
+		return sh.ssi.CreateTeam(ctx, request.(CreateTeamRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreatePlayer")
+		handler = middleware(handler, "CreateTeam")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreatePlayerResponseObject); ok {
-		if err := validResponse.VisitCreatePlayerResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateTeamResponseObject); ok {
+		if err := validResponse.VisitCreateTeamResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -677,25 +4655,25 @@ func (sh *strictHandler) CreatePlayer(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeletePlayer operation middleware
-func (sh *strictHandler) DeletePlayer(w http.ResponseWriter, r *http.Request, id int64) {
-	var request DeletePlayerRequestObject
+// DeleteTeam operation middleware
+func (sh *strictHandler) DeleteTeam(w http.ResponseWriter, r *http.Request, id int64) {
+	var request DeleteTeamRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeletePlayer(ctx, request.(DeletePlayerRequestObject))
+		return sh.ssi.DeleteTeam(ctx, request.(DeleteTeamRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeletePlayer")
+		handler = middleware(handler, "DeleteTeam")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeletePlayerResponseObject); ok {
-		if err := validResponse.VisitDeletePlayerResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteTeamResponseObject); ok {
+		if err := validResponse.VisitDeleteTeamResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -703,25 +4681,25 @@ func (sh *strictHandler) DeletePlayer(w http.ResponseWriter, r *http.Request, id
 	}
 }
 
-// GetPlayer operation middleware
-func (sh *strictHandler) GetPlayer(w http.ResponseWriter, r *http.Request, id int64) {
-	var request GetPlayerRequestObject
+// GetTeam operation middleware
+func (sh *strictHandler) GetTeam(w http.ResponseWriter, r *http.Request, id int64) {
+	var request GetTeamRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetPlayer(ctx, request.(GetPlayerRequestObject))
+		return sh.ssi.GetTeam(ctx, request.(GetTeamRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetPlayer")
+		handler = middleware(handler, "GetTeam")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetPlayerResponseObject); ok {
-		if err := validResponse.VisitGetPlayerResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetTeamResponseObject); ok {
+		if err := validResponse.VisitGetTeamResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -729,13 +4707,13 @@ func (sh *strictHandler) GetPlayer(w http.ResponseWriter, r *http.Request, id in
 	}
 }
 
-// UpdatePlayer operation middleware
-func (sh *strictHandler) UpdatePlayer(w http.ResponseWriter, r *http.Request, id int64) {
-	var request UpdatePlayerRequestObject
+// UpdateTeam operation middleware
+func (sh *strictHandler) UpdateTeam(w http.ResponseWriter, r *http.Request, id int64) {
+	var request UpdateTeamRequestObject
 
 	request.Id = id
 
-	var body UpdatePlayerJSONRequestBody
+	var body UpdateTeamJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -743,18 +4721,44 @@ func (sh *strictHandler) UpdatePlayer(w http.ResponseWriter, r *http.Request, id
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdatePlayer(ctx, request.(UpdatePlayerRequestObject))
+		return sh.ssi.UpdateTeam(ctx, request.(UpdateTeamRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdatePlayer")
+		handler = middleware(handler, "UpdateTeam")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdatePlayerResponseObject); ok {
-		if err := validResponse.VisitUpdatePlayerResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateTeamResponseObject); ok {
+		if err := validResponse.VisitUpdateTeamResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListPlayersV2 operation middleware
+func (sh *strictHandler) ListPlayersV2(w http.ResponseWriter, r *http.Request, params ListPlayersV2Params) {
+	var request ListPlayersV2RequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListPlayersV2(ctx, request.(ListPlayersV2RequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListPlayersV2")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListPlayersV2ResponseObject); ok {
+		if err := validResponse.VisitListPlayersV2Response(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -765,29 +4769,137 @@ func (sh *strictHandler) UpdatePlayer(w http.ResponseWriter, r *http.Request, id
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xX32/bNhD+Vw7cHlJAsCWnTVIDBZYma5ch24ymxR6aYGCks8SWIlmSSuoG/t+HI2U7",
-	"spXlB9p0D31JZInHu/u++47HK5br2miFyjs2vmIur7Dm4XEi+QwtPRmrDVovMLznJdI//MxrI5GNt58n",
-	"rOafRd3UbPwsTVgtVPyRPUuYnxlkYyaUxxItmycsF158QeUqYTr7sHcn+yxYH6MqfcXGo6W581aokqwr",
-	"FGXlybBAl1thvNCKjdlv4T0IBbWQUtTo0TrYwkE5SGCU7qRQ1/ACRoN0B+onLFn5pY+doNO0L2xRdKLN",
-	"EjbVtuY+rtp5yvqMFK+7YLFjfGm1YgGyRZ4taIufWU/aVsu4kaIY37PJa5awk/DnFUvYhP4csLNracUP",
-	"Gxu5xm4G9Tuv0d07Jo+8PlpDJe3DZYVtH0aXNzD695LR0vJ6yWWWbadpCiW8oMdBCh/LDp3x+zWnz9K0",
-	"j1ECFT81wmJBgIqCtXStMEpCrS9rbhlqt4ZbcpZ4nC196fMPmHvKMWrpwCL3eAdF7fzvFZU9z1pFZYPn",
-	"2Zqi6OMdFLVZhyceTYWPoo6w5G7qOGisnX0ddYy+tTr2dhbi2NvZ1Eb4em9pfGNVvDNFq4puhq8EysKB",
-	"19CEFQOW3Kab3R+6+aGbh+lmd6mb3R7d7N5VN2s1Tq+EmmqKI9fK8zyEhDUXkrJujNHW/9I6GuS6XpxC",
-	"Y7Y/OYKTuIAS6qaxD06QCbz59eQt0NKptlBzxUuhSjjn7iP6cy4lEJ5ggtLc4FSdqreVcMHCWH0hCnRw",
-	"8ObdIZCwOO3ubtyq3QUuha+EAk47TNE5oRWXIJGXDQ5O1ZEHLqW+dMAbX2krvmABuRQ0Y5Kc83AMJmCR",
-	"F0kr7gS4KqBAiR5bP2F3IdElIFQum4KiMWhd8Fag50K65FSZauZEziVw7604bzxZXJN2AkY7QZnBFhX8",
-	"k+gr4MKnUyFFSDtgc4hOlAqLAEHgNWISUo4mARisUXlwM+exdgm4XDc+hCe5p0J10UeEBJznXjgvcgcO",
-	"7YXIkZggHQkflPJyhXHsifDHysv+5Igl7AKti9Sng3SQUUVog4obwcZse5AOtqk9cl+FzjhsqaLnEkPN",
-	"LfklcbFj4fykXUN2lsc+xcbv1xUz4SWCaupztLDlPLfewdTqGjLSiKAlnxoMSm8r1/AS/4kWdG6EK0VU",
-	"4pQ30m8Mz9ujW+Q8T9aD+jPGo6cgiAKqCyC3sNUeAJCl6X/G58QX7I9ulPaFtzhXsk4n6Av2jI5PZ7Ry",
-	"8ZgapelC/6gCF9wYKfLAxvCDo4SurgUSMqKHny1O2Zj9NFzd0YbtBW3Y3s5WLYdby2ex43ShIqoJqEVJ",
-	"zBP2NEbUXXekLrgUBQhlGg8F9zx0NNfUNbczNmav0YNsN7vWDqiOeenC+dG+OZsnzGjXU3dxBG6Dj2MG",
-	"Ov9SF7N7QXQ7Mu2sPe8OM942ON+gJ/vKvvtYaHXtmjxH56aNlLO2ERYPZyQmCRwUXrZ09LIxT5YdYXgl",
-	"inl0Rb12k6HD8H7J0FprCHKiNrNSU7g5dSG+Lqtbb8k9enm6iUUffjGFFr+bbZT2MNWNKtbQO+ycNucz",
-	"ODq8oZZ7W+hr9N8XpfTxyjbW3sNgprZxB4xN04NxvBs8Oszfqim1V507NaX0OzWlOI/dryk9rDAiGrfX",
-	"RrBCe9E/nhzrPIyDFyi1iWNZWMsS1lgasivvzXg4lLSu0s6P99K9lBHRrav1Hf9aTcJh7NucgFf1tohy",
-	"fjb/NwAA//9fyQGsSxUAAA==",
+	"H4sIAAAAAAAC/+x9a3PctrLgX0Fxt2rtc6nRSPJTKdeubMeJcuzE15KPT+1RyoMhe2YQkSADgJImKf33",
+	"W90NPofzkm3ZTvwlsYYA0d1oNPrNP4MoS/NMg3Y2OPwzmIGMwdA/n2XagXbPlc0zq5zKNP4ag42MyvnP",
+	"4KSYTsE6iMVEJSC0TEFkEyFFnF3qJJNxEAZwJdM8geAwkM7JaJaCdt/ReBz+5CzIEzkHYweRvTgLgjCw",
+	"0QxSiYu5eY7zrDNKT4Pr6zD4/lROF8H4Xjvl5sLJKa7uZiCiwhjQTlyAsSrT5c+8VAuos+Bg7aovlT5f",
+	"XPVneaGmEv8QidLnVtx58+KZeLT/6NHdgRgZSJ6cBRqu3FkwEnmmtLPCZQQG/ipyOQUhdSyUFVmqHFIx",
+	"0/Q8kZafD850G9hiODyIdj3F/m9UGJuZJzD/6Y/j3zIl3/23evnsp/3j3/Lxq9+expP/xvH7D/BN7636",
+	"A57sD+kN8J1ogrcS++swyKWRKTjPF8cxpHnmQEfzf8J8kSxvtfq9AHEO85LqBn4vwDoRzTILWoznvEWJ",
+	"Au1CAYPpQEjx9u3x84H4J8ytkAan505MMoNDz3SU6YmaFgZicXr6UuRgRCSTBMxAHNFK1qkkEUqL3GRT",
+	"A9YiVaW2l4BzLpWbiXvDx0xOhVAyowdhgEwYHDax2kG0miRJ5dVL0FM3Cw73798Pg1Tp8u+9sIddjiev",
+	"pItmi6R5A78XykA8EKczECNk5lGbNemf0UzqKSAGY2mJK0KRGTH6xwgZKMV3C6nnJXMPzvSRSJW1Sk8F",
+	"44VzDfwGkauw338UCimskwmITIP/dW9/FU0mO4zI6tNxPPk507AE5fpo2saui5m0IpLRDGKEilFa2LGD",
+	"4b0VoOGiG8H3mij7TDn1B2g7U/kLlTgwi7D+opO53wfLELiZsiKqZ5bg/F6AmdfQtEc0GKdmlP1wOWR0",
+	"invAySUeJD7kYmKylCg4wkM7IpHDsjY3cKGywrLEECi3lS6A6Z0o65AvjJrO3JmWEwemIWOY6YgFJYuc",
+	"UMAFaKEmNSXAgJBxjJxohIE0u4BYpCD15UwlKKNeFdaJMYjClluHK1i8DUY2M24kJEPTApWEXyS1zmhy",
+	"lKVjpcsXjEho6SIdgxk1eLRLeabdEqLvLSf6sY6SIobnkICDeBlHHCU2IxKKmAdWRCF6oaBC6PPCTCEW",
+	"c3DiTgJyWsB7GadKi0wn87tLQFcMwXv/5hYOMUxkkbjgcCITCxUW4yxLQOoGGq/k1dEUloH/Sl6ptEgF",
+	"EvsOrWfVBSwDKJVX7+UUuqIP3xAc3h+S3OM/9u5XICntYAqmDdKPgNy2DqoZjUKhnaokUXzDbAgnz+3u",
+	"ewndcLgavncbwXdZwTc1Mt0UsssVkN0fDleDpvSq3VR6m91U+qPsptJrdtNDdePdVPqDdlPpdxvBd4Pd",
+	"VPqDdvO1nMLPJMAWocJngqWbuGOdNM6yhN+7OxAnkEDkrMgmEwsklZUmPTMkITpRxquHfM27wpDYnIEu",
+	"FcnBEpQaQrWF0yQzqXSMxcF+0GSONQieqD+gRzVmzLKJUA5SSwobAXzHs6DYGw7vrgIS1dV+kbg/DHvg",
+	"LTl7bzjcEPo3WQJbagImS2AJ0P5RDe//NjAJDoP/tVsbWLv81O568pVmVQ3TSWbcIjTPsjSVOxZQD8c7",
+	"CK9U1HptKGSeJwpiZOvMxKwPJyBjvPJHOyMaavEpvhE0/U4DwzNNijfdz0928OK3hUFkRgPx2mOO95tM",
+	"LuXc8iSIUXkfqXhE6kMobEbbyiOtk+OkMlnyJIuhusD6SIYrt0hGvLIZ7ZBQqKZf09Yf88TH1W5LYyQ9",
+	"tG5OhhMyTFCR+RRkehxvtPleO3cg0wYjHD9fwgY47r2Klx6uB/fWHa7rMDBg80xbIFo8lfEbtp/wr4iN",
+	"cvwnbX1EcmE3N9k4gfS/frNspW/IhjyLF23T4FhfyEQhX+WFE7F0Eqn3LNOTREW3Csmb0nj0a9tawSyN",
+	"fOukg9rYtFlhIvDwIoynWfZSmincJtinDbt3nMVzFNUJAoHat2QzP8vB0PJCRhHkziLMLzIzVnEM+rah",
+	"ZYP6/7CUE3EGlnVbMKlybXgH4iTKCocmHOu4woCMwzNNxySVWk7BNJ6mWawm3TOljMguNZ8stARoJGvP",
+	"grRnfoGfO5UpWJQt12Hwc+aOiGAob26TTGhylnyWQqykwONr/faxdGwYuQj+GNAlERcRxB70F1mh49s9",
+	"QXwiaDsntDpKQgNRpmO6gV5IlUB82ww3Kv0LIwG1A69iPHYKrHDmNU96E5vSy/K5jnvqreEKIJmEwoKO",
+	"W8h474/SDTogJidgLlQEb7W8kCq5bQ5HPCyYCzClZe7kOZR+yRJDciYInV2GQlVsbsAZVEUS6cAgJm+1",
+	"LNwsM+qP292LV94Vlhmh/DU2BmnACJedgw7Cppf73bt3O0eFmyEHRtJBG4IFZyihlZssAmtxb57VON3m",
+	"FqnaW0nuz0tphUxQCM/ZCYOeUyliNZkAcZvfOd4WW+R5ZhzEr1CGnRKOn/FmRF+gFH55kqid27ESCSxn",
+	"SV/2CyEcLxQk8ffGsA8tNzjRKdaeJvisz3WfVoK88m4jw/x08svP7Kwv3WLAQPKLWtEMMq47HBIGKXIG",
+	"6xr1WG/plZJBOrzorBN794M+F5WpJNh/gnLh8r2/VhOyMTp4cc0fSPns4i4v5fwkykyPffaawxEeQRxI",
+	"9zCKKSconnHB7rmJ0jLhy7eJ/N7wUbjCaOyxikMChxXvFm32hvvhooq8OH2WpbARNjhwS2z29rfGBlfp",
+	"xWZvI2xUe9r9Dach18dFAvGRa80P9of793eGBzt79073Hh8eDA+Hw/8fNF4ZSwc7TqW9LIv6c7HW8EIm",
+	"O+GR12FwAbroMPkzM89dNoiyVBwZ0JLN8soX6w3zlb7ZJuOTFdUgc4uD2qQowalQWXZInhmQroeDjogp",
+	"yLLICsdxEldQEKlaB30qKw7YB52GzgWGYoJlNzuFRjUZRu1j2Hd2VpmXnXP0KZl+NRh/J07ejomX8e5J",
+	"hVtH+pURTy//kJMH4qW64H9akcq5iKQx87bRjDwQNkXimab7aSYvOEKIErMCEC/HPLMOaRn7F9NInenS",
+	"56Nxt/9TIxWEAYpe3D1cBc9P+QbEsqZ3+XhhPxHvt3nce2ZfFEkiDOSJjCBFjBr483VtQ8bSigQmTtDJ",
+	"NmidgTRrz/NHvOs+1+m+vQvu21m/2VlfeWGhex0zT2yfWmtsh5a7F/tVXkjDzf9kbzEHpI+aieQXdmUL",
+	"WDxaFFugOMSUPMDN4GwQbgLFwWZgYGx7M7w2fCFGnD8qoSwkk81euL/JCzvsQ28P/f72MgUt1KPsd0yO",
+	"g8fhFuG+sJXC0MLu7clREK7OZAgDH8N+L3t46B3yTiPDBW1VPz5kp59X0zsh9mWCAK1cdNEHh84U0AOM",
+	"jyYuAPJjf4QSwyCh2B8+GIo0FU/E/mD4QKR3m2yND8N1IckFpX4zlV57262aFryEpybTbTl0f50YCjkC",
+	"dfhndQ2//iEIgxP6z4sgDF7jf5617116sMjhHAdqA/WTN5q2g8l9nAvEO/4Wt/RYR4Yuf86dgwswc58+",
+	"xbHSvhyr5s4ebLRHl0s46l03psy8tLd3MBwOxVQ8wX8OhuJ8erdtbx4MW2HK5ZHkBYOIdqbeo9C7IaoI",
+	"ehWwbuck+fCkKy+jkqLLRcxRESv3vXZm3iNsIteXsPSMIgicGpPKGJrJbNKKEWcvpuBmWUz/hkP+yRa0",
+	"OP828imBo98u3aFMVAQjyn6TuXp/DvPDSI3CM42/2Ll1kI7I2cXLWF4XYxMq9qmo3tfUyaSsXt53AihL",
+	"arNAJA4fw8RrXJuNZ1hLabmZttORLfc2c5pUPrTNgKM9/6Wag9cn/f7+BpKtj3nrt4Weh5ogtgizhjF/",
+	"aSJWSryIrfswKNhkKO8lujSswz36tYe0/Nqn6IF/RTHrRrJBIF2Wqqhh3VQ/jMG69zCZZMate20L2vZR",
+	"8ny72f54U6hih46y5jNIM8H446mpCLARs2xEzD5c80orWY+E98GsEuv/6sva7kOMkkeV5VDRRKrEVnmt",
+	"wZa3TIdfs3wFC9KmNqLy7S1NPROtJ0XNc82zarfMheiwWCsl4r6/Z/yfe90MiQWsKxjWYs85Cj22SRVM",
+	"XGSxG5LGgC0SdyO6vKGp+JKF1JAiigDiflA7dCG4m1PCEs0auPUEI4nSJZfSMVz1+bQ5Oag8A3U0RLVi",
+	"cb0neZvjSON9tGbToE7T+O7o2Kenr0vnaRvsy6xIYnYZzSRpawp99pc6FPf277GFqRxZByZL0OM0ltF5",
+	"Wwtff2yZmqvN6qYUWm9HPbhNO+pGpsve4z1vuuwNHu91TBd8uIHpsqjwnzjIZ3ArZggN2cwMeVYYM98a",
+	"Jrdp2Gm1GbKlGfDoQWkFPHqwaATQ061tgI+i/i8/FT8qVJN6NP5K7G4hfxsWRI/4RU/P+2hJ6QOXRJSy",
+	"ryqXCoUckz+qWye11q/CYC9H/DjNM+PeUMlMr54GxmRmcwo0YtI9uCdK9zi0X6o6u6jIsXqO/mmyS+Ez",
+	"hKVjq/bZyb8ahT74NrHX5K2Ha/mIIAhLrNbTJfeJqW2ixGb+3hS6cYFWFQphoFJOMliVHVxmhLGyGVOZ",
+	"E5mPfFuMoXyCXkcpYjMXuF7f4SzLnbZk0u7G9+yWyS7tKiQwOxJ3yZZXM2/eepuoJJ9fokGyBjrL9+al",
+	"6tM+b3JSl/Do+frplYv605/osJW9viqjPhT5ln7rvZ7wSI9aVSWmN2+S/eFGk13mZLLJWSBjBgHmXP/E",
+	"sS+0Xu/e/RuY38QMJRRhK8eed3o5n71ulP9+sHPRp2+DNMtV4m3VVyP7ynPfQAIXUkd1xiYSNhQzNZ2x",
+	"4ByDc2134HDw6GHT7ZwV46TBip73usSt3IoEyHJClknryyx3ClqimUsJ/uM58fFEXVU1cTvs6+rm8g8a",
+	"7gnyrew0XYU7CzrDTld92OloETuL+sTOEs1ip1fR2On6G3cWVI8uSywN81Iktzb+e8K2XZX94TeV/ZvK",
+	"fjOV/WGlsj/sUdkfbqqyLwqA2sLu1EcbQyfakfOKOgg8fDR8eHcgvqdgBmlooqwICQVXjeLRp8xWtq3R",
+	"F1EYzOCgfgLagcGsDpprQ0r+UGCpVJjAEHEWFSlox4kbHaWOYOmB9CpPJNehCZtDpCYq4oYGyoos4ryS",
+	"Wtr6pZr0CzpVNCpupKT38ECtc/cXp3CSR6fLQH35Z7qPRkH4MTR4pa3Du6VPD3GzDkis0kaSMnOX0Wa3",
+	"DnsuHlB+z/s+r+/x8wUCSKxaruoCvVI6+veO91juHD8fiaqgvobg3njyeH9ycP/hw/HBvVg+kAcRPN5/",
+	"HA9hCPceHjxYnYSx3A8UsfOsjl30yzOnXNJDz5MZXoa2SFNpqo4S5woLRia9hMSEi9V8xT8s9K14cyxU",
+	"DNqpybxUv1atUxh96H8/RMbe0ZnbWbJmR2GgpyXGK51ViExP0EC5eSdmnFlxpKeQ3CBIq26WwtoTuK6B",
+	"EC/lOZgOLDdLAfWaCuG8jETLvHmLhDqRWrwwUkfKRtnWpFrE+YcsiUGLEypBe4eCNvtgtDfBeNusOBK9",
+	"LDAXNai/IplQOEFUGOXmJyjSGVMuBMGCj/qvFyWvH70+5rY1Rvz07jToFi4caVGOuDOKx+fvB4PB6G7l",
+	"oWAVPYaLLLc7Y2nPwY1lkgiZK5zCwwQHvBH0MtpNsWyJKwqrprrZQaPR9ubHV0fPhIXIgBM0Ht9Z9QRp",
+	"DPzp3T9P+Fqnm4ycMoRmfYhnzuVcmaH0JCtrPyTXdUJKt3/gi0T+n99DTJCry1yRDic8IFgo8DgSVuEU",
+	"8eb7k1MiGRotVBVIdnhNGmLKsv3TmT7Tox++PxXlTTjydxgqLmMqRcbbt2kq++5JVa8guPLdTrgAD9/5",
+	"M1yWfwk7I69SYUHwQnXi1igUlzMVzcSlkbnvVUIF9lpILUBfQJLlvmmO9yek4CS6fgjwU9RnENXcZBcq",
+	"BiuevXn7vA5/2KU0aFaZ42r4hglYS3Vjvh5ycKaPnZBJgk6muqiqQsxlnr1CLsT0plJIBOJIqV+H3q4S",
+	"sE1NMgdjaTWvhoZnOp/NrYpkIqRzRo0LhzMahlIoyqZg4g6aD3d5LdpQOZmoRHGhKNKGFdk6EuTPMG6f",
+	"GB15bOjRoXjKhVp8Sqhcyx8Tr7CEwgLnlYzqozwS5UkXzPO8LGmPlPFrgP1qlIWyrLhpVGnFTT28saDX",
+	"4Ue8CO/7c/BndkLVZg6mZbiLznBdDkuymBNWOC25IEbNE+nwrrVMP95uUpyQkyNLxXgq4tLXSkcKntb8",
+	"430Hr+pVjl4fNzJ8DoPhYDjY8xFmLXMVHAYHg+HggNxAbkZycZeLYg7/DKbQYy5hFm2rB0CdF+5UCqHI",
+	"ci50TOac0udmmSVTINN895zpO9JxbQ6Kr0s5v0sYV2yP7xFG6rLpWcUvaAUG6Ov8waegNduR/ae3eJ+Q",
+	"6a/bp3OAntpQdOD5ZBX94QoYazpKluu+P5OyTJD1XUrwDugHbkU+0YYwcWJTExy4WgOOyz4CMCu6o2zZ",
+	"2KRK5NkLt21y8jX1NPm10zRifzhcUdK5WMq5kUWM52/RFl4s8KRzGgqQJlFgHferwYn3hsNla1TQ7zb6",
+	"XdCUvfVTWoXGNOlg/aS6zwKpimxfIvTAzQ7KU4AbXlYMOjlFmcMYBr9ec0ELrtWWVmyO/CB9vjJh8zSL",
+	"51vtybqtKPOo2mqxMwVcL3DD3kddedmmC8qPsXZS4D3g9eIveeNxxuP1M6q2J21OOSk5RAoNl8QkPTxy",
+	"HfrLdfdPFV/zqU/AwSLXcC86zzWdS45kCt7WtUhRcdDd+JV31AZC417/zd/e17Jf3W1u0r31M6rOGu1N",
+	"YqLS5qDecvy8Z4vCUulp78cP4D7nZgxv58yWvX2+gr1E0bxmI/PC9UUCS2Fu6wpB8qpHmYktJaJVfWPr",
+	"OnGqHRwsqKLsebllzvg0V0iZT7zJFTL8DFcIm7Ff/BWyFUd/2J3DO7b6FOCNUwYTlhl0ZYe3skWRnEIs",
+	"QLkZmIVECXFnoRHhXZHxOE73GJ3pO9iPrj1mIN75evr267pNbOvwFL9tmf33ugqQdE5dHyXrIbsL3Riv",
+	"wy3mUIPDjWe0OsttPKvRinDjOYv9jDee2mozuvkseXWTWZ3+odssd8OJnY6g26x4s4m9vYQ3nn3CTtRN",
+	"t52OSHA7Ft+y7LBF2Y3ns+GZbfdYKtvn9y3lh+3SmOvrL1jSL6giice54cVtSONSWjWtxE5kE3RMPtBO",
+	"73nq8m7AmbmwcgLJ/FBI/3e7t/Y5zENBjoaw1T6J0wCobRFFguY+F9+oKSk2+8O9KqPAhy0qENzOG5oC",
+	"8aFwpgDsUGYdYMb+5EyTTYd+S6kzuisY7z6Jzebp6zI1ajuR3fnGwKfSgdoFSbdrSJdna/Es8ZO/vjEd",
+	"Bvf29zeBq6ffWvs08g56O7zKxls8iQ3NaBeuypzmXgXpxBnAxCAuKeZJ7dTMsrO+T9EMK/d4os4RFv+c",
+	"v/XAar5Q9kzXX8EY8a8jnCogsVD2jxxxe8s66MFxHHIWUrTn2cm/sNF/UqQUWcLAEH8oY8TIjnyo9zt/",
+	"vAutnH0yK1KpR2WnbvK++38aEJdGOQeaQiQ84tWIstc4cU3q+EyPePw/uW/huUoyzp6qRUQr2Q1XoAED",
+	"Qe5lKSZwKZjwVphCk9NbRxCe6UlhSKBkGsqYTfPzFfeHB6LQTiWibsGJ8Wyt7Axsn/z5npb5MJ3xmzb3",
+	"TZu7qTa3kDyXGefFQEhNNdHnABHEnDSHHTfLY78s0kOTWwGEqljXXuC4mK6gMLhK7FVPqu0iWO+MctAn",
+	"EKygeulY3EnTUEzvcjPNhjCoT/8SaEnk9Ec7AiMvGynL/BcJpz6gt9N0L3Q8yHLQV2nC9LI72WSiIqhS",
+	"Hm1uQMZ2BuDSZED/b9/alUtmrLQklBbo2FryascTfmXr0DBwcOV2cadWjluiDlTFJf76qJhh8YtdO51P",
+	"dq1Su3s+8nV9/YUrGQ82crc0OkRfh8H9Tdbpab3b1jP8Ka7STyxexKH4+Tm1Dc2M+PfLk3+v1Ty4ygfB",
+	"6bcLWJuhdF1fF5AZr4dw3itWhLXqxAYIh6C8Di4V40tTlkViWqZea6k0BF6k1BPEnREe2/ITAKGcQshS",
+	"IWSJEDZzP0yWQMgJ2qO7nCIzZ+3nuzPticHAzLIkphu7syonaVEINVEaymRnRExZEc0gOseQ+FSiZtEL",
+	"d5Rp64ykxp8ojip1gQqxWkkf4zktUhUHkS1EykY59kyXlVfCZVPAZ+y8EiNfo/WEbSFfj8cLVGBSzrUF",
+	"QxVs44IAFvxG0vga9cz0yQQ3K6HDlKRLKy7pY0LCgEzKeVwAF2ffCeX8180Q5SLFe4PZ4vg5Zupw2hI1",
+	"Xa4Y056rfCDeEm8wrIlKFaMnDvbFK/W0T2XiWrilKlOHSxH7Zq1i2TyTEUB+U27J3VAXvm3xyaOVNuA/",
+	"dv+xpVg9EqNSIJf9ZRZF+qiu5bs9/3hP5WXfFxqYT4wf8NeyC/cONprQ+rgDzbu/CT49/a8/ojHqN6Y8",
+	"i5S3guI5M/6iWHtBWKqOW2qaUq94sGL0+6gSkTwVBT2wPPSS3PdDQSfCDrK74HfTEGcUKnBn2qpUJdIo",
+	"NyfxVHUNx9ZsOZDs8i824GUR5bkPBFfw8YNGdpgpS+/6pAzX/m0oZXiwQNDLtlDPMx2piM/sy+JcCv/D",
+	"Elnz+8rw24r84/0N9OfyU2G6yg0qu4H0A0Ni+CtOAeqp3tzAOfyqdJt4Bg9FmllXcsnXliDkWbI83+O5",
+	"0O3Mj/5DvZj90SWSObfNdkd1m8SBeN75+iCetxkBx/KFLJqw/hIDG29nmr0/r385ORUtQA79kBEJAnyb",
+	"/4Rh/X3IRkq5AQfap/MuHGcGbZmf91MExNeb4uUHWDdLcenzt37xSS542W1wX/V85YWuukfbTa0+qdKb",
+	"XOMZthuPbkZAliXYfGl8U3/J9pNm5Kx1/lNWTsu4L798vcqapzH00oM+RuevPGEqTLllypa2BXcF/5Al",
+	"v5IEog2YNe//lPERbjfZ5WThvgIzBfEax/p6gYPHD+6Wn/j2gTF2fOMPtvCf6vMWNzf+G5zpZvG/bXwP",
+	"139ol1SykS6SZITmf9Fxj/eJZILpS5bIm0TyUiTvDm3Ff93kcH2e3KbtonplftMHnbq/a17UF3EDvpbG",
+	"KVnv5UbipS9HkdnV9oqKVhvMgfiFv/FaPj0HyP339KoPpZGcQNliYIKBhddHp89+HPlg4KpTxg1GcsbK",
+	"49QbXmN4v3Yh802wfBMsX6ZgebuhOOmamLuzuoVff+Ynfa/XzUxWTDmXSGKfPuGMVElb1ISYT0HfXuUe",
+	"3mSnl756X3B6pr3ga5SaKutTq1u1zNwcXOq4sUJZYSZ1y/QsVSNUWD0+GNeYW1FFZhrDa122tJd75FVl",
+	"a5QtDr8QmdWTaNopzMvl7wX41Fj/wZhGp7W6t72BC5UVtuye1ud+4indr5mvKLj/9DZQuR19gk1Ooc2Q",
+	"JTP8pRLCe6vP/IkrmZ8a2W+Y2NT07ywPMr7hAY0ooz88ZUUuB6m840fkYFQWe3Nm8SO9frmqln/h+Pnl",
+	"blNf+PWLuY1Lf9xftSjBb66QFQ9txqqH49LQ7ufR0uAucrSq7w+HzX4K9Rfvj7UYcVf+EX4tGight0wR",
+	"MwV1zKYydCO1ldTmkzNkucGisa5sDkWdpy0FaukhwXfI4+iGb3Ux4DQ2XzxEbbXoGkuSM06OtX4M9rdm",
+	"KBufDPCgQrc3QqFtoze2D23XK+FvHGIYnOnSZqivXsufGMUjxImEvgtA5wso+M85uRHG0iJWOuSsxfpT",
+	"xH136NPar2CDT6lit/rrfxZFu93jvv+j2kVC6d+dLQzLRB3izk5vri/84vqo6bhlF5LFJiR0eMdFcr5U",
+	"QDiQabN4abEY6JRGrIkefusj8CUGEXHrtqkpYWb4WitDnGfUktGZcdf1DsBRn0jENvql3XLJA298zxfB",
+	"QaZ/s94BrYoFx5vd5ZBKEK6NHR/RK0gTp+47Y6i1+RloobM6XA1JpqeoTynO+FWuaomD46hVwWBJlNdz",
+	"5ZfSm2CRb76GsO0HcI2Pt9Jmd11CtVxZFmv9nLs3vB0h8pU1M1izkXnRs5Gs9N/yXn6aW+jzeNI3v4W+",
+	"tR9Y0n5gJd/itVU3VlxeYIc2allDJ63vyNhox2hknnP7Ylmmj6NGOOp0Y6Qub5mTyZmus/DSTsYZ3XRa",
+	"Xqgpm9n0yYM1fQb+tf+t08C32rRvnQY+vR8VD92qAMDfqamAFHkb696es/2Ok0bLY5JWzWbH//kVNxK7",
+	"ifa7Sl5mETWAJdHKzUppbBAGhUl80+DD3d0Ex80y6w4fDR8NiTs8JIuBq8pXSygs9ryttZQSietwi7eU",
+	"lrV/x6l3EyxcNFWbNvKlcsJ62dRPjMFdAujuy7iXzvWv1/8zAPxVFPIhowAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -1,9 +1,24 @@
 package main
 
 import (
+	"log/slog"
+	"os"
+
 	"github.com/arsnazarenko/devops-basketball/internal/app"
 )
 
 func main() {
-	app.Run()
+	run := app.Run
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "migrate":
+			run = func() error { return app.Migrate(os.Args[2:]) }
+		case "apikey":
+			run = func() error { return app.APIKey(os.Args[2:]) }
+		}
+	}
+	if err := run(); err != nil {
+		slog.Error("exiting", slog.Any("error", err))
+		os.Exit(1)
+	}
 }
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
 )
@@ -10,23 +11,98 @@ const pathToConfig = "./config/config.yml"
 
 type (
 	Config struct {
-		HTTP     `yaml:"http"`
-		Postgres `yaml:"postgres"`
-		Metrics  `yaml:"metrics"`
+		HTTP        `yaml:"http"`
+		Postgres    `yaml:"postgres"`
+		Metrics     `yaml:"metrics"`
+		Log         `yaml:"log"`
+		Tracing     `yaml:"tracing"`
+		Auth        `yaml:"auth"`
+		Players     `yaml:"players"`
+		Idempotency `yaml:"idempotency"`
 	}
 
 	HTTP struct {
 		Host string `env-required:"true" yaml:"host" env:"HTTP_HOST"`
 		Port string `env-required:"true" yaml:"port" env:"HTTP_PORT"`
+		// ShutdownTimeout bounds how long in-flight requests may drain on shutdown
+		ShutdownTimeout time.Duration `yaml:"shutdown_timeout" env:"HTTP_SHUTDOWN_TIMEOUT" env-default:"15s"`
 	}
 
 	Postgres struct {
 		PostgresURL string `env-required:"true" yaml:"postgres_url" env:"POSTGRES_URL"`
+		AutoMigrate bool   `yaml:"auto_migrate" env:"POSTGRES_AUTO_MIGRATE" env-default:"false"`
+
+		MaxConns          int           `yaml:"max_conns" env:"POSTGRES_MAX_CONNS" env-default:"10"`
+		MinConns          int           `yaml:"min_conns" env:"POSTGRES_MIN_CONNS" env-default:"0"`
+		MaxConnLifetime   time.Duration `yaml:"max_conn_lifetime" env:"POSTGRES_MAX_CONN_LIFETIME" env-default:"1h"`
+		MaxConnIdleTime   time.Duration `yaml:"max_conn_idle_time" env:"POSTGRES_MAX_CONN_IDLE_TIME" env-default:"30m"`
+		HealthCheckPeriod time.Duration `yaml:"health_check_period" env:"POSTGRES_HEALTH_CHECK_PERIOD" env-default:"1m"`
+		ConnectTimeout    time.Duration `yaml:"connect_timeout" env:"POSTGRES_CONNECT_TIMEOUT" env-default:"5s"`
+		// StatementTimeout aborts statements running longer, 0 disables it
+		StatementTimeout time.Duration `yaml:"statement_timeout" env:"POSTGRES_STATEMENT_TIMEOUT" env-default:"30s"`
+		ApplicationName  string        `yaml:"application_name" env:"POSTGRES_APPLICATION_NAME" env-default:"devops-basketball"`
+		// ConnectAttempts and ConnectBackoff control retries of the initial connection
+		ConnectAttempts   int           `yaml:"connect_attempts" env:"POSTGRES_CONNECT_ATTEMPTS" env-default:"10"`
+		ConnectBackoff    time.Duration `yaml:"connect_backoff" env:"POSTGRES_CONNECT_BACKOFF" env-default:"500ms"`
+		ConnectMaxBackoff time.Duration `yaml:"connect_max_backoff" env:"POSTGRES_CONNECT_MAX_BACKOFF" env-default:"10s"`
 	}
 
 	Metrics struct {
 		Host string `env-required:"true" yaml:"host" env:"METRICS_HOST"`
 		Port string `env-required:"true" yaml:"port" env:"METRICS_PORT"`
+		// ProbeTimeout bounds the checks run by the readiness probe
+		ProbeTimeout time.Duration `yaml:"probe_timeout" env:"METRICS_PROBE_TIMEOUT" env-default:"2s"`
+	}
+
+	Log struct {
+		// Level is one of debug, info, warn, error
+		Level string `yaml:"level" env:"LOG_LEVEL" env-default:"info"`
+		// Format is either json or text
+		Format string `yaml:"format" env:"LOG_FORMAT" env-default:"json"`
+	}
+
+	Auth struct {
+		// Enabled requires a bearer token on every API operation
+		Enabled bool `yaml:"enabled" env:"AUTH_ENABLED" env-default:"true"`
+		// HMACSecret verifies HS256/384/512 JWTs
+		HMACSecret string `yaml:"hmac_secret" env:"AUTH_HMAC_SECRET"`
+		// JWKSFile is a local JSON Web Key Set verifying asymmetrically signed JWTs
+		JWKSFile string `yaml:"jwks_file" env:"AUTH_JWKS_FILE"`
+		Issuer   string `yaml:"issuer" env:"AUTH_ISSUER"`
+		Audience string `yaml:"audience" env:"AUTH_AUDIENCE"`
+	}
+
+	Players struct {
+		// PurgeRetention is how long deleted players can be restored before they are purged
+		PurgeRetention time.Duration `yaml:"purge_retention" env:"PLAYERS_PURGE_RETENTION" env-default:"720h"`
+		// PurgeInterval is how often deleted players are purged
+		PurgeInterval time.Duration `yaml:"purge_interval" env:"PLAYERS_PURGE_INTERVAL" env-default:"1h"`
+	}
+
+	Idempotency struct {
+		// TTL is how long responses to requests with an Idempotency-Key are replayed
+		TTL time.Duration `yaml:"ttl" env:"IDEMPOTENCY_TTL" env-default:"24h"`
+		// Lease is how long a request may hold its key without completing. The key
+		// of a request that crashed is claimed again after it, so it must exceed
+		// the longest request.
+		Lease time.Duration `yaml:"lease" env:"IDEMPOTENCY_LEASE" env-default:"5m"`
+		// CleanupInterval is how often expired keys are deleted
+		CleanupInterval time.Duration `yaml:"cleanup_interval" env:"IDEMPOTENCY_CLEANUP_INTERVAL" env-default:"1h"`
+	}
+
+	Tracing struct {
+		Enabled     bool   `yaml:"enabled" env:"TRACING_ENABLED" env-default:"false"`
+		ServiceName string `yaml:"service_name" env:"TRACING_SERVICE_NAME" env-default:"devops-basketball"`
+		// Exporter is either otlp or stdout
+		Exporter string `yaml:"exporter" env:"TRACING_EXPORTER" env-default:"otlp"`
+		// Endpoint is the host:port of the OTLP collector
+		Endpoint string `yaml:"endpoint" env:"TRACING_ENDPOINT" env-default:"localhost:4317"`
+		// Protocol of the OTLP exporter, grpc or http
+		Protocol string `yaml:"protocol" env:"TRACING_PROTOCOL" env-default:"grpc"`
+		Insecure bool   `yaml:"insecure" env:"TRACING_INSECURE" env-default:"false"`
+		// File receives spans of the stdout exporter, stdout when empty
+		File        string  `yaml:"file" env:"TRACING_FILE"`
+		SampleRatio float64 `yaml:"sample_ratio" env:"TRACING_SAMPLE_RATIO" env-default:"1"`
 	}
 )
 
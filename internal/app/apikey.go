@@ -0,0 +1,92 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/arsnazarenko/devops-basketball/config"
+	"github.com/arsnazarenko/devops-basketball/internal/auth"
+	"github.com/arsnazarenko/devops-basketball/internal/usecase/repo"
+	"github.com/arsnazarenko/devops-basketball/pkg/postgres"
+)
+
+const apiKeyUsage = "usage: devops-basketball apikey create <name> scout|team_manager <team id>|league_admin | revoke <id>"
+
+// APIKey runs the apikey subcommand with its arguments.
+func APIKey(args []string) error {
+	if len(args) < 2 {
+		return errors.New(apiKeyUsage)
+	}
+
+	config, err := config.NewConfig()
+	if err != nil {
+		return err
+	}
+
+	pgOpts, err := postgresOptions(config.Postgres)
+	if err != nil {
+		return err
+	}
+	pg, err := postgres.New(config.PostgresURL, pgOpts...)
+	if err != nil {
+		return err
+	}
+	defer pg.Close()
+
+	keys := repo.NewAPIKeyRepo(pg)
+	ctx := context.Background()
+
+	switch args[0] {
+	case "create":
+		role, teamID, err := parseAPIKeyRole(args[2:])
+		if err != nil {
+			return err
+		}
+		key, hash, err := auth.GenerateAPIKey()
+		if err != nil {
+			return err
+		}
+		id, err := keys.CreateAPIKey(ctx, args[1], hash, role, teamID)
+		if err != nil {
+			return err
+		}
+		// the key itself is not stored, so it is shown only once
+		fmt.Printf("id: %d\nkey: %s\n", id, key)
+		return nil
+	case "revoke":
+		if len(args) != 2 {
+			return errors.New(apiKeyUsage)
+		}
+		id, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid id %q: %w", args[1], err)
+		}
+		return keys.RevokeAPIKey(ctx, id)
+	default:
+		return errors.New(apiKeyUsage)
+	}
+}
+
+// parseAPIKeyRole parses the role of a new key and the team of a team manager.
+func parseAPIKeyRole(args []string) (auth.Role, *int64, error) {
+	if len(args) == 0 || !auth.Role(args[0]).Valid() {
+		return "", nil, errors.New(apiKeyUsage)
+	}
+	role := auth.Role(args[0])
+	if role != auth.RoleTeamManager {
+		if len(args) != 1 {
+			return "", nil, errors.New(apiKeyUsage)
+		}
+		return role, nil, nil
+	}
+	if len(args) != 2 {
+		return "", nil, errors.New(apiKeyUsage)
+	}
+	teamID, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid team id %q: %w", args[1], err)
+	}
+	return role, &teamID, nil
+}
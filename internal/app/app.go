@@ -2,67 +2,198 @@
 package app
 
 import (
-	"log"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
 	"net"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/arsnazarenko/devops-basketball/api/gen"
 	"github.com/arsnazarenko/devops-basketball/config"
+	"github.com/arsnazarenko/devops-basketball/internal/auth"
+	"github.com/arsnazarenko/devops-basketball/internal/controller/http/middleware"
 	v1 "github.com/arsnazarenko/devops-basketball/internal/controller/http/v1"
+	"github.com/arsnazarenko/devops-basketball/internal/health"
 	"github.com/arsnazarenko/devops-basketball/internal/metrics"
 	"github.com/arsnazarenko/devops-basketball/internal/usecase"
 	"github.com/arsnazarenko/devops-basketball/internal/usecase/repo"
+	"github.com/arsnazarenko/devops-basketball/migrations"
+	"github.com/arsnazarenko/devops-basketball/pkg/logger"
+	"github.com/arsnazarenko/devops-basketball/pkg/migrate"
 	"github.com/arsnazarenko/devops-basketball/pkg/postgres"
+	"github.com/arsnazarenko/devops-basketball/pkg/tracing"
+	"github.com/getkin/kin-openapi/openapi3filter"
 	"github.com/go-chi/chi/v5"
-	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/cors"
-	oapi_middleware "github.com/oapi-codegen/nethttp-middleware"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
-func Run() {
+// Run starts the API and metrics servers and blocks until SIGINT or SIGTERM
+// is received or one of the servers fails. On shutdown the servers stop
+// accepting connections and drain in-flight requests within the configured
+// timeout, then the database pool is closed.
+func Run() error {
 	config, err := config.NewConfig()
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 
+	l, err := logger.New(os.Stdout, config.Log.Level, config.Log.Format)
+	if err != nil {
+		return err
+	}
+	slog.SetDefault(l)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
 	swagger, err := gen.GetSwagger()
 	if err != nil {
-		log.Fatalf("Error loading swagger spec: %s", err)
+		return fmt.Errorf("error loading swagger spec: %w", err)
 	}
 	swagger.Servers = nil
 
-	pg, err := postgres.New(config.PostgresURL)
+	pgOpts, err := postgresOptions(config.Postgres)
 	if err != nil {
-		log.Fatal(err)
+		return err
+	}
+	if err := checkJobIntervals(config); err != nil {
+		return err
 	}
+	if config.Tracing.Enabled {
+		shutdownTracing, err := tracing.Setup(ctx, tracing.Config{
+			ServiceName: config.Tracing.ServiceName,
+			Exporter:    config.Tracing.Exporter,
+			Endpoint:    config.Tracing.Endpoint,
+			Protocol:    config.Tracing.Protocol,
+			Insecure:    config.Tracing.Insecure,
+			File:        config.Tracing.File,
+			SampleRatio: config.Tracing.SampleRatio,
+		})
+		if err != nil {
+			return err
+		}
+		// deferred before pg.Close, so spans are flushed last
+		defer func() {
+			ctx, cancel := context.WithTimeout(context.Background(), config.HTTP.ShutdownTimeout)
+			defer cancel()
+			if err := shutdownTracing(ctx); err != nil {
+				l.Error("tracing shutdown", slog.Any("error", err))
+			}
+		}()
+		pgOpts = append(pgOpts, postgres.Tracing())
+	}
+
+	pg, err := postgres.New(config.PostgresURL, pgOpts...)
+	if err != nil {
+		return err
+	}
+	// closed last, after both servers have drained
 	defer pg.Close()
+
+	m, err := migrate.New(pg.Pool, migrations.FS)
+	if err != nil {
+		return err
+	}
+	if config.AutoMigrate {
+		if err := m.Up(ctx); err != nil {
+			return err
+		}
+		l.Info("database migrated", slog.Uint64("version", uint64(m.Latest())))
+	}
+
+	// database pool and runtime metrics
+	if err := prometheus.Register(metrics.NewPoolCollector(pg.Pool)); err != nil {
+		return err
+	}
+	if err := metrics.RegisterRuntimeCollectors(prometheus.DefaultRegisterer); err != nil {
+		return err
+	}
+
+	// readiness checks
+	checker := health.New(config.Metrics.ProbeTimeout)
+	checker.Add("postgres", pg.Pool.Ping)
+	checker.Add("migrations", func(ctx context.Context) error {
+		version, err := m.Version(ctx)
+		if err != nil {
+			return err
+		}
+		if version != m.Latest() {
+			return fmt.Errorf("database is at version %d, want %d", version, m.Latest())
+		}
+		return nil
+	})
+
+	authenticate := openapi3filter.NoopAuthenticationFunc
+	var authenticator *auth.Authenticator
+	if config.Auth.Enabled {
+		authenticator, err = auth.New(repo.NewAPIKeyRepo(pg), auth.Config{
+			HMACSecret: config.Auth.HMACSecret,
+			JWKSFile:   config.Auth.JWKSFile,
+			Issuer:     config.Auth.Issuer,
+			Audience:   config.Auth.Audience,
+		})
+		if err != nil {
+			return err
+		}
+		authenticate = auth.AuthenticationFunc
+	}
+
 	// create chi router
 	r := chi.NewRouter()
-	// create PlayerServer
-	repo := repo.NewPlayerRepo(pg)
-	player := usecase.NewPlayerUsecase(repo)
-	serversImpl := v1.NewPlayersServerImpl(player)
+	// create Server
+	playerRepo := repo.NewPlayerRepoMetrics(repo.NewPlayerRepo(pg))
+	var player usecase.Player = usecase.NewPlayerUsecase(playerRepo)
+	if config.Tracing.Enabled {
+		player = usecase.NewPlayerTracing(player)
+	}
+	if config.Auth.Enabled {
+		player = usecase.NewPlayerPolicy(player)
+	}
+	var team usecase.Team = usecase.NewTeamUsecase(repo.NewTeamRepoMetrics(repo.NewTeamRepo(pg)))
+	var game usecase.Game = usecase.NewGameUsecase(repo.NewGameRepoMetrics(repo.NewGameRepo(pg)))
+	if config.Auth.Enabled {
+		team = usecase.NewTeamPolicy(team)
+		game = usecase.NewGamePolicy(game)
+	}
+	serversImpl := v1.NewServer(player, team, game)
 
-	server := gen.NewStrictHandler(serversImpl, []gen.StrictMiddlewareFunc{})
+	server := v1.NewStrictHandler(serversImpl)
 
 	// cors
 	corsHandler := cors.Handler(cors.Options{
 		AllowedOrigins:   []string{"https://*", "http://*"},
-		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
-		ExposedHeaders:   []string{"Link"},
+		AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", "If-Match", "If-None-Match", v1.IdempotencyKeyHeader, middleware.RequestIDHeader, "traceparent", "tracestate"},
+		ExposedHeaders:   []string{"Link", "WWW-Authenticate", "ETag", v1.IdempotentReplayedHeader, middleware.RequestIDHeader},
 		AllowCredentials: false,
 		MaxAge:           300, // Maximum value not ignored by any of major browsers
 	})
+	// request id and access log wrap everything, so validation failures are logged too
+	r.Use(middleware.RequestID())
+	r.Use(middleware.Tracing())
+	r.Use(middleware.AccessLog(l))
+	r.Use(middleware.Recoverer(l))
 	// cors middleware
 	r.Use(corsHandler)
+	// bearer token authentication, enforced per operation by the validator
+	if authenticator != nil {
+		r.Use(auth.Middleware(authenticator))
+	}
+	// limits uploads before the validator reads them
+	r.Use(v1.LimitImportSize)
 	// openapi validation middleware
-	r.Use(oapi_middleware.OapiRequestValidator(swagger))
-	r.Use(middleware.Logger)
-	r.Use(middleware.Recoverer)
+	r.Use(v1.RequestValidator(swagger, authenticate))
 	// metrics middleware
 	r.Use(metrics.HTTPMetricsMiddleware())
+	// replays responses to retried POST /players, after the validator authenticated the caller
+	idempotencyKeys := repo.NewIdempotencyRepo(pg)
+	r.Use(v1.Idempotency(idempotencyKeys, config.Idempotency.TTL, config.Idempotency.Lease))
 
 	gen.HandlerFromMux(server, r)
 	s := &http.Server{
@@ -71,21 +202,63 @@ func Run() {
 	}
 
 	// metrics server
-	go func() {
-		mr := chi.NewMux()
-		mr.Use(corsHandler)
-		mr.Use(middleware.Logger)
-		mr.Handle("/metrics", promhttp.Handler())
-
-		ms := &http.Server{
-			Handler: mr,
-			Addr:    net.JoinHostPort(config.Metrics.Host, config.Metrics.Port),
+	mr := chi.NewMux()
+	mr.Use(middleware.RequestID())
+	mr.Use(middleware.AccessLog(l))
+	mr.Use(middleware.Recoverer(l))
+	mr.Use(corsHandler)
+	mr.Handle("/metrics", promhttp.Handler())
+	mr.Get("/healthz", checker.Liveness)
+	mr.Get("/readyz", checker.Readiness)
+
+	ms := &http.Server{
+		Handler: mr,
+		Addr:    net.JoinHostPort(config.Metrics.Host, config.Metrics.Port),
+	}
+
+	serveErr := make(chan error, 2)
+	serve := func(name string, srv *http.Server) {
+		if err := srv.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+			serveErr <- fmt.Errorf("%s server: %w", name, err)
+		}
+	}
+	l.Info("metrics server started", slog.String("addr", ms.Addr))
+	go serve("metrics", ms)
+	l.Info("server started", slog.String("addr", s.Addr))
+	go serve("http", s)
+	// stops with ctx on shutdown
+	go runEvery(ctx, config.Players.PurgeInterval, usecase.NewPlayerPurge(playerRepo, l, config.Players.PurgeRetention).Purge)
+	go runEvery(ctx, config.Idempotency.CleanupInterval, func(ctx context.Context) {
+		deleted, err := idempotencyKeys.DeleteExpiredIdempotencyKeys(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				l.Error("delete expired idempotency keys", slog.Any("error", err))
+			}
+			return
+		}
+		if deleted > 0 {
+			l.Info("deleted expired idempotency keys", slog.Int64("count", deleted))
 		}
-		log.Printf("Metrics server started on port %s", config.Metrics.Port)
-		log.Fatal(ms.ListenAndServe())
-	}()
+	})
+
+	select {
+	case <-ctx.Done():
+		l.Info("shutting down")
+	case err = <-serveErr:
+		l.Error("shutting down", slog.Any("error", err))
+	}
+	stop()
+	checker.SetShuttingDown()
 
-	log.Printf("Server started on port %s", config.HTTP.Port)
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), config.HTTP.ShutdownTimeout)
+	defer cancel()
 
-	log.Fatal(s.ListenAndServe())
+	// drain API requests first, so that metrics still cover them until the end
+	if shutdownErr := s.Shutdown(shutdownCtx); shutdownErr != nil {
+		err = errors.Join(err, fmt.Errorf("http server shutdown: %w", shutdownErr))
+	}
+	if shutdownErr := ms.Shutdown(shutdownCtx); shutdownErr != nil {
+		err = errors.Join(err, fmt.Errorf("metrics server shutdown: %w", shutdownErr))
+	}
+	return err
 }
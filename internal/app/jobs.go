@@ -0,0 +1,35 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/arsnazarenko/devops-basketball/config"
+)
+
+// checkJobIntervals rejects background job intervals runEvery cannot tick with.
+func checkJobIntervals(cfg *config.Config) error {
+	if cfg.Players.PurgeInterval <= 0 {
+		return fmt.Errorf("players: purge_interval %s must be positive", cfg.Players.PurgeInterval)
+	}
+	if cfg.Idempotency.CleanupInterval <= 0 {
+		return fmt.Errorf("idempotency: cleanup_interval %s must be positive", cfg.Idempotency.CleanupInterval)
+	}
+	return nil
+}
+
+// runEvery calls job right away and then every interval until ctx is done.
+// interval must be positive.
+func runEvery(ctx context.Context, interval time.Duration, job func(context.Context)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		job(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
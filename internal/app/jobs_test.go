@@ -0,0 +1,46 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/arsnazarenko/devops-basketball/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckJobIntervals(t *testing.T) {
+	var cfg config.Config
+	cfg.Players.PurgeInterval = time.Hour
+	cfg.Idempotency.CleanupInterval = time.Hour
+	require.NoError(t, checkJobIntervals(&cfg))
+
+	cfg.Players.PurgeInterval = 0
+	require.Error(t, checkJobIntervals(&cfg))
+
+	cfg.Players.PurgeInterval = time.Hour
+	cfg.Idempotency.CleanupInterval = -time.Minute
+	require.Error(t, checkJobIntervals(&cfg))
+}
+
+func TestRunEvery(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	runs := make(chan struct{}, 1)
+	done := make(chan struct{})
+	go func() {
+		runEvery(ctx, time.Hour, func(context.Context) { runs <- struct{}{} })
+		close(done)
+	}()
+
+	select {
+	case <-runs:
+	case <-time.After(time.Second):
+		t.Fatal("job did not run right away")
+	}
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("runEvery did not return after cancel")
+	}
+}
@@ -0,0 +1,106 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/arsnazarenko/devops-basketball/config"
+	"github.com/arsnazarenko/devops-basketball/migrations"
+	"github.com/arsnazarenko/devops-basketball/pkg/logger"
+	"github.com/arsnazarenko/devops-basketball/pkg/migrate"
+	"github.com/arsnazarenko/devops-basketball/pkg/postgres"
+)
+
+const migrateUsage = "usage: devops-basketball migrate up|down|status|to <version>"
+
+// Migrate runs the migrate subcommand with its arguments.
+func Migrate(args []string) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+
+	config, err := config.NewConfig()
+	if err != nil {
+		return err
+	}
+
+	l, err := logger.New(os.Stdout, config.Log.Level, config.Log.Format)
+	if err != nil {
+		return err
+	}
+	slog.SetDefault(l)
+
+	// migrations may run far longer than any request
+	pgConfig := config.Postgres
+	pgConfig.StatementTimeout = 0
+	pgOpts, err := postgresOptions(pgConfig)
+	if err != nil {
+		return err
+	}
+	pg, err := postgres.New(config.PostgresURL, pgOpts...)
+	if err != nil {
+		return err
+	}
+	defer pg.Close()
+
+	m, err := migrate.New(pg.Pool, migrations.FS)
+	if err != nil {
+		return err
+	}
+
+	return runMigrate(context.Background(), l, m, args)
+}
+
+func runMigrate(ctx context.Context, l *slog.Logger, m *migrate.Migrator, args []string) error {
+	switch {
+	case args[0] == "up" && len(args) == 1:
+		if err := m.Up(ctx); err != nil {
+			return err
+		}
+	case args[0] == "down" && len(args) == 1:
+		if err := m.Down(ctx); err != nil {
+			return err
+		}
+	case args[0] == "to" && len(args) == 2:
+		version, err := strconv.ParseUint(args[1], 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid version %q: %w", args[1], err)
+		}
+		if err := m.To(ctx, uint(version)); err != nil {
+			return err
+		}
+	case args[0] == "status" && len(args) == 1:
+		return printMigrateStatus(ctx, m)
+	default:
+		return errors.New(migrateUsage)
+	}
+
+	version, err := m.Version(ctx)
+	if err != nil {
+		return err
+	}
+	l.Info("database migrated", slog.Uint64("version", uint64(version)))
+	return nil
+}
+
+func printMigrateStatus(ctx context.Context, m *migrate.Migrator) error {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT")
+	for _, status := range statuses {
+		appliedAt := "pending"
+		if status.AppliedAt != nil {
+			appliedAt = status.AppliedAt.Format("2006-01-02 15:04:05 MST")
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\n", status.Version, status.Name, appliedAt)
+	}
+	return w.Flush()
+}
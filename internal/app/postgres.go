@@ -0,0 +1,26 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/arsnazarenko/devops-basketball/config"
+	"github.com/arsnazarenko/devops-basketball/pkg/postgres"
+)
+
+// postgresOptions converts the Postgres config into connection pool options.
+func postgresOptions(cfg config.Postgres) ([]postgres.Option, error) {
+	if cfg.MinConns > cfg.MaxConns {
+		return nil, fmt.Errorf("postgres: min_conns %d exceeds max_conns %d", cfg.MinConns, cfg.MaxConns)
+	}
+	return []postgres.Option{
+		postgres.MaxPoolSize(cfg.MaxConns),
+		postgres.MinPoolSize(cfg.MinConns),
+		postgres.MaxConnLifetime(cfg.MaxConnLifetime),
+		postgres.MaxConnIdleTime(cfg.MaxConnIdleTime),
+		postgres.HealthCheckPeriod(cfg.HealthCheckPeriod),
+		postgres.ConnTimeout(cfg.ConnectTimeout),
+		postgres.StatementTimeout(cfg.StatementTimeout),
+		postgres.ApplicationName(cfg.ApplicationName),
+		postgres.ConnAttempts(cfg.ConnectAttempts, cfg.ConnectBackoff, cfg.ConnectMaxBackoff),
+	}, nil
+}
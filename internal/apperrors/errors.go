@@ -1,10 +1,68 @@
 package apperrors
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrPlayerNotFound             = errors.New("player not found")
+	ErrPlayerNotDeleted           = errors.New("player is not deleted")
+	ErrVersionMismatch            = errors.New("player was changed since the given version")
+	ErrPreconditionRequired       = errors.New("request must be conditional on the player version")
+	ErrTeamNotFound               = errors.New("team with this id not found")
+	ErrForbidden                  = errors.New("operation is not permitted for the caller")
+	ErrAPIKeyNotFound             = errors.New("api key not found")
+	ErrTeamHasPlayers             = errors.New("team still has players")
+	ErrConstraintViolation        = errors.New("request violates a data constraint")
+	ErrInvalidPlayerPageSize      = errors.New("invalid page size for listing player")
+	ErrInvalidPlayerPageNumber    = errors.New("invalid page number for listing player")
+	ErrInvalidPlayerSort          = errors.New("invalid sort for listing player")
+	ErrInvalidPlayerCursor        = errors.New("invalid cursor for listing player")
+	ErrPlayerCursorWithPageNumber = errors.New("cursor cannot be combined with page number")
+	ErrInvalidHistoryCursor       = errors.New("invalid cursor for player history")
+	ErrInvalidIdempotencyKey      = errors.New("idempotency key must be 1 to 255 printable ASCII characters")
+	ErrIdempotencyKeyReused       = errors.New("idempotency key was used for a different request")
+	ErrIdempotencyKeyInProgress   = errors.New("a request with this idempotency key is still in progress")
+	ErrInvalidBatchOperation      = errors.New("batch operation lacks a field its op requires")
+	ErrBatchAborted               = errors.New("operation was rolled back because another operation of the batch failed")
+	ErrUnsupportedImportFormat    = errors.New("import accepts text/csv and application/x-ndjson")
+	ErrInvalidImportHeader        = errors.New("import header must name every player field once")
+	ErrImportTooLarge             = errors.New("import upload must not exceed 32 MiB")
+	ErrNotAcceptableExport        = errors.New("export is available as text/csv, application/x-ndjson and xlsx")
+	ErrTooManyExports             = errors.New("too many exports are running, retry later")
+	ErrInvalidTeamPageSize        = errors.New("invalid page size for listing team")
+	ErrInvalidTeamPageNumber      = errors.New("invalid page number for listing team")
+	ErrTeamHasGames               = errors.New("team still plays in games")
+	ErrGameNotFound               = errors.New("game not found")
+	ErrInvalidGamePageSize        = errors.New("invalid page size for listing game")
+	ErrInvalidGamePageNumber      = errors.New("invalid page number for listing game")
+	ErrInvalidGameRange           = errors.New("from must not be after to")
+)
 
 var (
-	ErrPlayerNotFound          = errors.New("player not found")
-	ErrTeamNotFound            = errors.New("team with this id not found")
-	ErrInvalidPlayerPageSize   = errors.New("invalid page size for listing player")
-	ErrInvalidPlayerPageNumber = errors.New("invalid page number for listing player")
+	// ErrInvalidField is wrapped by FieldError when a value is rejected by a check or not-null constraint.
+	ErrInvalidField = errors.New("invalid field value")
+	// ErrConflictingField is wrapped by FieldError when a value conflicts with other data,
+	// e.g. by a unique or foreign key constraint.
+	ErrConflictingField = errors.New("field value conflicts with existing data")
 )
+
+// FieldError is a data constraint violation caused by the value of a single field.
+// It wraps ErrInvalidField, ErrConflictingField or, for a reference to a
+// missing team, ErrTeamNotFound.
+type FieldError struct {
+	// Field is the API name of the field
+	Field string
+	// Constraint is the name of the violated database constraint
+	Constraint string
+	Err        error
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Err)
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
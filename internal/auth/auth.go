@@ -0,0 +1,198 @@
+// Package auth authenticates API callers by bearer tokens: API keys stored
+// hashed in the database and JWTs signed with an HMAC secret or a key from a JWKS.
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/MicahParks/keyfunc/v3"
+	"github.com/arsnazarenko/devops-basketball/internal/apperrors"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	MethodAPIKey = "api_key"
+	MethodJWT    = "jwt"
+
+	// APIKeyPrefix tells API keys apart from JWTs
+	APIKeyPrefix = "dbk_"
+)
+
+// Role grants a set of permissions.
+type Role string
+
+const (
+	// RoleScout can only read
+	RoleScout Role = "scout"
+	// RoleTeamManager can modify the players of their own team
+	RoleTeamManager Role = "team_manager"
+	// RoleLeagueAdmin can do anything
+	RoleLeagueAdmin Role = "league_admin"
+)
+
+// Valid reports whether r is a known role.
+func (r Role) Valid() bool {
+	switch r {
+	case RoleScout, RoleTeamManager, RoleLeagueAdmin:
+		return true
+	}
+	return false
+}
+
+var (
+	ErrUnauthenticated = errors.New("authentication required")
+	ErrInvalidToken    = errors.New("invalid bearer token")
+	// ErrUnavailable wraps failures to look up credentials. They say nothing
+	// about the token and are reported as server errors.
+	ErrUnavailable = errors.New("authentication unavailable")
+)
+
+// Principal is an authenticated caller.
+type Principal struct {
+	// Subject identifies the caller, the JWT subject or the API key name
+	Subject string
+	// Method is how the caller was authenticated, MethodAPIKey or MethodJWT
+	Method string
+	Role   Role
+	// TeamID is the team managed by a RoleTeamManager
+	TeamID *int64
+}
+
+// claims are the JWT claims the API understands.
+type claims struct {
+	jwt.RegisteredClaims
+	Role   Role   `json:"role"`
+	TeamID *int64 `json:"team_id,omitempty"`
+}
+
+// KeyStore looks up API keys.
+type KeyStore interface {
+	// FindAPIKey returns the principal of the active API key with the given SHA-256 hash
+	// or apperrors.ErrAPIKeyNotFound.
+	FindAPIKey(ctx context.Context, hash []byte) (*Principal, error)
+}
+
+// Config describes how JWTs are verified. JWTs are rejected when neither
+// HMACSecret nor JWKSFile is set.
+type Config struct {
+	HMACSecret string
+	JWKSFile   string
+	// Issuer and Audience are checked when set
+	Issuer   string
+	Audience string
+}
+
+// Authenticator resolves bearer tokens into principals.
+type Authenticator struct {
+	keys    KeyStore
+	hmac    []byte
+	jwks    keyfunc.Keyfunc
+	methods []string
+	opts    []jwt.ParserOption
+}
+
+func New(keys KeyStore, cfg Config) (*Authenticator, error) {
+	a := &Authenticator{
+		keys: keys,
+	}
+	if cfg.HMACSecret != "" {
+		a.hmac = []byte(cfg.HMACSecret)
+		a.methods = append(a.methods, "HS256", "HS384", "HS512")
+	}
+	if cfg.JWKSFile != "" {
+		raw, err := os.ReadFile(cfg.JWKSFile)
+		if err != nil {
+			return nil, fmt.Errorf("auth.New: %w", err)
+		}
+		a.jwks, err = keyfunc.NewJWKSetJSON(json.RawMessage(raw))
+		if err != nil {
+			return nil, fmt.Errorf("auth.New: jwks: %w", err)
+		}
+		a.methods = append(a.methods, "RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA")
+	}
+
+	a.opts = []jwt.ParserOption{jwt.WithValidMethods(a.methods), jwt.WithExpirationRequired()}
+	if cfg.Issuer != "" {
+		a.opts = append(a.opts, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		a.opts = append(a.opts, jwt.WithAudience(cfg.Audience))
+	}
+	return a, nil
+}
+
+// Authenticate returns the principal of a bearer token.
+func (a *Authenticator) Authenticate(ctx context.Context, token string) (*Principal, error) {
+	if strings.HasPrefix(token, APIKeyPrefix) {
+		return a.authenticateAPIKey(ctx, token)
+	}
+	return a.authenticateJWT(token)
+}
+
+func (a *Authenticator) authenticateAPIKey(ctx context.Context, key string) (*Principal, error) {
+	principal, err := a.keys.FindAPIKey(ctx, HashAPIKey(key))
+	if errors.Is(err, apperrors.ErrAPIKeyNotFound) {
+		return nil, ErrInvalidToken
+	}
+	if err != nil {
+		return nil, fmt.Errorf("auth.Authenticate: %w: %w", ErrUnavailable, err)
+	}
+	return principal, nil
+}
+
+func (a *Authenticator) authenticateJWT(raw string) (*Principal, error) {
+	if len(a.methods) == 0 {
+		return nil, ErrInvalidToken
+	}
+	var c claims
+	if _, err := jwt.ParseWithClaims(raw, &c, a.keyfunc, a.opts...); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+	if c.Subject == "" {
+		return nil, fmt.Errorf("%w: missing subject", ErrInvalidToken)
+	}
+	if !c.Role.Valid() {
+		return nil, fmt.Errorf("%w: unknown role %q", ErrInvalidToken, c.Role)
+	}
+	if c.Role == RoleTeamManager && c.TeamID == nil {
+		return nil, fmt.Errorf("%w: team_manager without team_id", ErrInvalidToken)
+	}
+	return &Principal{
+		Subject: c.Subject,
+		Method:  MethodJWT,
+		Role:    c.Role,
+		TeamID:  c.TeamID,
+	}, nil
+}
+
+// keyfunc picks the verification key by the signing method of the token.
+func (a *Authenticator) keyfunc(token *jwt.Token) (any, error) {
+	if _, ok := token.Method.(*jwt.SigningMethodHMAC); ok {
+		return a.hmac, nil
+	}
+	return a.jwks.Keyfunc(token)
+}
+
+// GenerateAPIKey returns a new random API key and the hash to store.
+func GenerateAPIKey() (key string, hash []byte, err error) {
+	var b [32]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", nil, fmt.Errorf("auth.GenerateAPIKey: %w", err)
+	}
+	key = APIKeyPrefix + base64.RawURLEncoding.EncodeToString(b[:])
+	return key, HashAPIKey(key), nil
+}
+
+// HashAPIKey returns the SHA-256 hash under which key is stored.
+func HashAPIKey(key string) []byte {
+	sum := sha256.Sum256([]byte(key))
+	return sum[:]
+}
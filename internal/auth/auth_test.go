@@ -0,0 +1,168 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/arsnazarenko/devops-basketball/internal/apperrors"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type keyStore map[string]string
+
+func (s keyStore) FindAPIKey(_ context.Context, hash []byte) (*Principal, error) {
+	name, ok := s[string(hash)]
+	if !ok {
+		return nil, apperrors.ErrAPIKeyNotFound
+	}
+	return &Principal{Subject: name, Method: MethodAPIKey, Role: RoleScout}, nil
+}
+
+func signHMAC(t *testing.T, secret string, claims jwt.MapClaims) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+	require.NoError(t, err)
+	return token
+}
+
+func TestAuthenticateAPIKey(t *testing.T) {
+	key, hash, err := GenerateAPIKey()
+	require.NoError(t, err)
+	a, err := New(keyStore{string(hash): "ci"}, Config{})
+	require.NoError(t, err)
+
+	p, err := a.Authenticate(context.Background(), key)
+	require.NoError(t, err)
+	assert.Equal(t, &Principal{Subject: "ci", Method: MethodAPIKey, Role: RoleScout}, p)
+
+	_, err = a.Authenticate(context.Background(), APIKeyPrefix+"unknown")
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
+
+type failingKeyStore struct{}
+
+func (failingKeyStore) FindAPIKey(context.Context, []byte) (*Principal, error) {
+	return nil, errors.New("connection refused")
+}
+
+func TestAuthenticateAPIKeyStoreFailure(t *testing.T) {
+	a, err := New(failingKeyStore{}, Config{})
+	require.NoError(t, err)
+
+	_, err = a.Authenticate(context.Background(), APIKeyPrefix+"key")
+	assert.ErrorIs(t, err, ErrUnavailable)
+	assert.NotErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestAuthenticateHMAC(t *testing.T) {
+	a, err := New(keyStore{}, Config{HMACSecret: "secret", Issuer: "league"})
+	require.NoError(t, err)
+	exp := time.Now().Add(time.Hour).Unix()
+
+	p, err := a.Authenticate(context.Background(), signHMAC(t, "secret", jwt.MapClaims{"role": "scout", "sub": "alice", "iss": "league", "exp": exp}))
+	require.NoError(t, err)
+	assert.Equal(t, &Principal{Subject: "alice", Method: MethodJWT, Role: RoleScout}, p)
+
+	teamID := int64(7)
+	p, err = a.Authenticate(context.Background(), signHMAC(t, "secret", jwt.MapClaims{"role": "team_manager", "team_id": 7, "sub": "carol", "iss": "league", "exp": exp}))
+	require.NoError(t, err)
+	assert.Equal(t, &Principal{Subject: "carol", Method: MethodJWT, Role: RoleTeamManager, TeamID: &teamID}, p)
+
+	invalid := map[string]string{
+		"wrong secret":  signHMAC(t, "other", jwt.MapClaims{"role": "scout", "sub": "alice", "iss": "league", "exp": exp}),
+		"expired":       signHMAC(t, "secret", jwt.MapClaims{"role": "scout", "sub": "alice", "iss": "league", "exp": time.Now().Add(-time.Hour).Unix()}),
+		"no expiration": signHMAC(t, "secret", jwt.MapClaims{"role": "scout", "sub": "alice", "iss": "league"}),
+		"wrong issuer":  signHMAC(t, "secret", jwt.MapClaims{"role": "scout", "sub": "alice", "iss": "other", "exp": exp}),
+		"missing sub":   signHMAC(t, "secret", jwt.MapClaims{"role": "scout", "iss": "league", "exp": exp}),
+		"not a jwt":     "garbage",
+	}
+	for name, token := range invalid {
+		t.Run(name, func(t *testing.T) {
+			_, err := a.Authenticate(context.Background(), token)
+			assert.ErrorIs(t, err, ErrInvalidToken)
+		})
+	}
+}
+
+func TestAuthenticateJWKS(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	jwks, err := json.Marshal(map[string]any{"keys": []map[string]string{{
+		"kty": "RSA",
+		"kid": "k1",
+		"alg": "RS256",
+		"use": "sig",
+		"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}}})
+	require.NoError(t, err)
+	file := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(file, jwks, 0o600))
+
+	a, err := New(keyStore{}, Config{JWKSFile: file})
+	require.NoError(t, err)
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{"role": "league_admin", "sub": "bob", "exp": time.Now().Add(time.Hour).Unix()})
+	token.Header["kid"] = "k1"
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+
+	p, err := a.Authenticate(context.Background(), signed)
+	require.NoError(t, err)
+	assert.Equal(t, "bob", p.Subject)
+
+	// HMAC tokens are rejected when no secret is configured
+	_, err = a.Authenticate(context.Background(), signHMAC(t, "", jwt.MapClaims{"role": "league_admin", "sub": "bob", "exp": time.Now().Add(time.Hour).Unix()}))
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestMiddlewareAndAuthenticationFunc(t *testing.T) {
+	a, err := New(keyStore{}, Config{HMACSecret: "secret"})
+	require.NoError(t, err)
+	valid := signHMAC(t, "secret", jwt.MapClaims{"role": "scout", "sub": "alice", "exp": time.Now().Add(time.Hour).Unix()})
+
+	cases := map[string]struct {
+		header string
+		want   error
+	}{
+		"valid token":   {"Bearer " + valid, nil},
+		"invalid token": {"Bearer nope", ErrInvalidToken},
+		"no token":      {"", ErrUnauthenticated},
+		"basic auth":    {"Basic YWxpY2U6cHc=", ErrUnauthenticated},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got error
+			h := Middleware(a)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = AuthenticationFunc(r.Context(), &openapi3filter.AuthenticationInput{
+					RequestValidationInput: &openapi3filter.RequestValidationInput{Request: r},
+				})
+			}))
+			req := httptest.NewRequest(http.MethodGet, "/players", nil)
+			if tc.header != "" {
+				req.Header.Set("Authorization", tc.header)
+			}
+			h.ServeHTTP(httptest.NewRecorder(), req)
+
+			if tc.want == nil {
+				assert.NoError(t, got)
+			} else {
+				assert.ErrorIs(t, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3filter"
+)
+
+type resultKey struct{}
+
+// result is the outcome of authenticating the request.
+type result struct {
+	principal *Principal
+	err       error
+}
+
+// WithPrincipal returns a copy of ctx carrying p.
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, resultKey{}, result{principal: p})
+}
+
+// PrincipalFrom returns the authenticated caller, if any.
+func PrincipalFrom(ctx context.Context) (*Principal, bool) {
+	r, ok := ctx.Value(resultKey{}).(result)
+	return r.principal, ok && r.principal != nil
+}
+
+// Middleware authenticates the bearer token of the request, if any. It does
+// not reject requests itself: AuthenticationFunc enforces authentication for
+// the operations that require it.
+func Middleware(a *Authenticator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, ok := bearerToken(r)
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+			principal, err := a.Authenticate(r.Context(), token)
+			ctx := context.WithValue(r.Context(), resultKey{}, result{principal: principal, err: err})
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return "", false
+	}
+	return strings.TrimSpace(token), true
+}
+
+// AuthenticationFunc is the openapi3filter hook that enforces the bearer
+// security scheme of an operation with the outcome of Middleware.
+func AuthenticationFunc(_ context.Context, input *openapi3filter.AuthenticationInput) error {
+	r, _ := input.RequestValidationInput.Request.Context().Value(resultKey{}).(result)
+	switch {
+	case r.principal != nil:
+		return nil
+	case r.err != nil:
+		return r.err
+	default:
+		return ErrUnauthenticated
+	}
+}
@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type errorSlotKey struct{}
+
+// errorSlot holds the error that caused a response, for the access log.
+type errorSlot struct {
+	err error
+}
+
+// RecordError attaches the cause of a failed response to its access log line.
+// It is a no-op outside of AccessLog.
+func RecordError(ctx context.Context, err error) {
+	if slot, ok := ctx.Value(errorSlotKey{}).(*errorSlot); ok {
+		slot.err = err
+	}
+}
+
+// AccessLog writes one log line per request with its route pattern, status,
+// latency, trace ID and, for failed requests, the error recorded with RecordError.
+// Requests answered with 5xx, or aborted with http.ErrAbortHandler after the
+// response started, are logged at error level.
+func AccessLog(l *slog.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			slot := &errorSlot{}
+			ctx := context.WithValue(r.Context(), errorSlotKey{}, slot)
+			ww := chimiddleware.NewWrapResponseWriter(w, r.ProtoMajor)
+
+			defer func() {
+				rvr := recover()
+				if rvr != nil && rvr != http.ErrAbortHandler {
+					panic(rvr)
+				}
+				logRequest(ctx, l, r, ww, slot, start, rvr != nil)
+				if rvr != nil {
+					panic(rvr)
+				}
+			}()
+			next.ServeHTTP(ww, r.WithContext(ctx))
+		})
+	}
+}
+
+// logRequest writes the access log line of a request.
+func logRequest(ctx context.Context, l *slog.Logger, r *http.Request, ww chimiddleware.WrapResponseWriter, slot *errorSlot, start time.Time, aborted bool) {
+	status := ww.Status()
+	if status == 0 {
+		status = http.StatusOK
+	}
+	route := r.URL.Path
+	if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+		route = rctx.RoutePattern()
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", r.Method),
+		slog.String("route", route),
+		slog.String("path", r.URL.Path),
+		slog.Int("status", status),
+		slog.Int("bytes", ww.BytesWritten()),
+		slog.Duration("latency", time.Since(start)),
+		slog.String("remote_addr", r.RemoteAddr),
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		attrs = append(attrs, slog.String("trace_id", sc.TraceID().String()))
+	}
+	if slot.err != nil {
+		attrs = append(attrs, slog.String("error", slot.err.Error()))
+	}
+	if aborted {
+		attrs = append(attrs, slog.Bool("aborted", true))
+	}
+	level := slog.LevelInfo
+	if status >= http.StatusInternalServerError || aborted {
+		level = slog.LevelError
+	}
+	l.LogAttrs(ctx, level, "http request", attrs...)
+}
@@ -0,0 +1,95 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/arsnazarenko/devops-basketball/pkg/logger"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessLog(t *testing.T) {
+	var buf bytes.Buffer
+	l, err := logger.New(&buf, "info", logger.FormatJSON)
+	require.NoError(t, err)
+
+	r := chi.NewRouter()
+	r.Use(RequestID())
+	r.Use(AccessLog(l))
+	r.Get("/players/{id}", func(w http.ResponseWriter, r *http.Request) {
+		RecordError(r.Context(), errors.New("boom"))
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/players/7", nil)
+	req.Header.Set(RequestIDHeader, "abc-123")
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	assert.Equal(t, "abc-123", rec.Header().Get(RequestIDHeader))
+
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(t, slog.LevelError.String(), entry["level"])
+	assert.Equal(t, "abc-123", entry["request_id"])
+	assert.Equal(t, "/players/{id}", entry["route"])
+	assert.Equal(t, float64(http.StatusInternalServerError), entry["status"])
+	assert.Equal(t, "boom", entry["error"])
+}
+
+func TestAccessLogAborted(t *testing.T) {
+	var buf bytes.Buffer
+	l, err := logger.New(&buf, "info", logger.FormatJSON)
+	require.NoError(t, err)
+
+	h := AccessLog(l)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		RecordError(r.Context(), errors.New("stream broke"))
+		panic(http.ErrAbortHandler)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/players/export", nil)
+	assert.PanicsWithValue(t, http.ErrAbortHandler, func() { h.ServeHTTP(httptest.NewRecorder(), req) })
+
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(t, slog.LevelError.String(), entry["level"])
+	assert.Equal(t, float64(http.StatusOK), entry["status"])
+	assert.Equal(t, true, entry["aborted"])
+	assert.Equal(t, "stream broke", entry["error"])
+}
+
+func TestRequestIDGenerated(t *testing.T) {
+	h := RequestID()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, w.Header().Get(RequestIDHeader), logger.RequestID(r.Context()))
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(RequestIDHeader, "bad id\n")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	assert.Len(t, rec.Header().Get(RequestIDHeader), 32)
+}
+
+func TestRecoverer(t *testing.T) {
+	var buf bytes.Buffer
+	l, err := logger.New(&buf, "info", logger.FormatJSON)
+	require.NoError(t, err)
+
+	h := Recoverer(l)(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		panic("oops")
+	}))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.Contains(t, buf.String(), "panic: oops")
+}
@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+)
+
+// Recoverer turns panics in handlers into 500 responses and logs them with the stack trace.
+func Recoverer(l *slog.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer func() {
+				rvr := recover()
+				if rvr == nil {
+					return
+				}
+				if rvr == http.ErrAbortHandler {
+					panic(rvr)
+				}
+				err := fmt.Errorf("panic: %v", rvr)
+				RecordError(r.Context(), err)
+				l.ErrorContext(r.Context(), "handler panicked", slog.Any("error", err), slog.String("stack", string(debug.Stack())))
+				w.WriteHeader(http.StatusInternalServerError)
+			}()
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package v1
+
+import "github.com/getkin/kin-openapi/openapi3filter"
+
+const mergePatchContentType = "application/merge-patch+json"
+
+func init() {
+	// JSON Merge Patch bodies are plain JSON documents, so the request validator
+	// can check them against the schema like any other JSON body.
+	openapi3filter.RegisterBodyDecoder(mergePatchContentType, openapi3filter.JSONBodyDecoder)
+	// Imports are parsed by the handler, which reports malformed rows instead of
+	// rejecting the upload, so the validator only needs to accept them.
+	openapi3filter.RegisterBodyDecoder(csvContentType, openapi3filter.PlainBodyDecoder)
+	openapi3filter.RegisterBodyDecoder(ndjsonContentType, openapi3filter.PlainBodyDecoder)
+}
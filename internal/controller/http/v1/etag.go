@@ -0,0 +1,56 @@
+package v1
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/arsnazarenko/devops-basketball/internal/apperrors"
+	"github.com/arsnazarenko/devops-basketball/internal/usecase"
+)
+
+// playerETag returns the strong entity tag of a player version.
+func playerETag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+// ifMatchVersion returns the player version an If-Match header requires.
+// "*" matches any version. Only a single strong entity tag can name a version,
+// anything else cannot match and fails with apperrors.ErrVersionMismatch.
+// A missing header fails with apperrors.ErrPreconditionRequired.
+func ifMatchVersion(header *string) (int64, error) {
+	if header == nil || strings.TrimSpace(*header) == "" {
+		return 0, apperrors.ErrPreconditionRequired
+	}
+	tag := strings.TrimSpace(*header)
+	if tag == "*" {
+		return usecase.AnyVersion, nil
+	}
+	unquoted, ok := strings.CutPrefix(tag, `"`)
+	if !ok {
+		return 0, apperrors.ErrVersionMismatch
+	}
+	unquoted, ok = strings.CutSuffix(unquoted, `"`)
+	if !ok {
+		return 0, apperrors.ErrVersionMismatch
+	}
+	version, err := strconv.ParseInt(unquoted, 10, 64)
+	if err != nil || version < 1 {
+		return 0, apperrors.ErrVersionMismatch
+	}
+	return version, nil
+}
+
+// noneMatch reports whether an If-None-Match header lists etag, using the weak
+// comparison of RFC 9110. "*" matches every existing player.
+func noneMatch(header *string, etag string) bool {
+	if header == nil {
+		return false
+	}
+	for _, tag := range strings.Split(*header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || strings.TrimPrefix(tag, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,119 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/arsnazarenko/devops-basketball/api/gen"
+	"github.com/arsnazarenko/devops-basketball/internal/apperrors"
+	"github.com/arsnazarenko/devops-basketball/internal/usecase"
+)
+
+type GamesServerImpl struct {
+	uc usecase.Game
+}
+
+func NewGamesServerImpl(uc usecase.Game) *GamesServerImpl {
+	return &GamesServerImpl{
+		uc: uc,
+	}
+}
+
+// CreateGame implements gen.StrictServerInterface.
+func (g *GamesServerImpl) CreateGame(ctx context.Context, request gen.CreateGameRequestObject) (gen.CreateGameResponseObject, error) {
+	created, err := g.uc.CreateGame(ctx, request.Body)
+	if errors.Is(err, apperrors.ErrForbidden) {
+		return gen.CreateGame403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx, err)}, nil
+	}
+	if errors.Is(err, apperrors.ErrTeamNotFound) || isInvalidField(err) {
+		return gen.CreateGame400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: badRequest(ctx, err)}, nil
+	}
+	if isConflict(err) {
+		return gen.CreateGame409ApplicationProblemPlusJSONResponse{ConflictApplicationProblemPlusJSONResponse: conflict(ctx, err)}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return gen.CreateGame201JSONResponse(*created), nil
+}
+
+// DeleteGame implements gen.StrictServerInterface.
+func (g *GamesServerImpl) DeleteGame(ctx context.Context, request gen.DeleteGameRequestObject) (gen.DeleteGameResponseObject, error) {
+	err := g.uc.DeleteGame(ctx, request.Id)
+	if errors.Is(err, apperrors.ErrForbidden) {
+		return gen.DeleteGame403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx, err)}, nil
+	}
+	if errors.Is(err, apperrors.ErrGameNotFound) {
+		return gen.DeleteGame404ApplicationProblemPlusJSONResponse{NotFoundApplicationProblemPlusJSONResponse: notFound(ctx, err)}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return gen.DeleteGame204Response{}, nil
+}
+
+// GetGame implements gen.StrictServerInterface.
+func (g *GamesServerImpl) GetGame(ctx context.Context, request gen.GetGameRequestObject) (gen.GetGameResponseObject, error) {
+	game, err := g.uc.GetGame(ctx, request.Id)
+	if errors.Is(err, apperrors.ErrForbidden) {
+		return gen.GetGame403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx, err)}, nil
+	}
+	if errors.Is(err, apperrors.ErrGameNotFound) {
+		return gen.GetGame404ApplicationProblemPlusJSONResponse{NotFoundApplicationProblemPlusJSONResponse: notFound(ctx, err)}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return gen.GetGame200JSONResponse(*game), nil
+}
+
+// ListGames implements gen.StrictServerInterface.
+func (g *GamesServerImpl) ListGames(ctx context.Context, request gen.ListGamesRequestObject) (gen.ListGamesResponseObject, error) {
+	params := usecase.GameListParams{
+		TeamID:     request.Params.TeamId,
+		From:       request.Params.From,
+		To:         request.Params.To,
+		PageSize:   defaultPageSize,
+		PageNumber: defaultPageNumber,
+	}
+	if request.Params.PageNumber != nil {
+		params.PageNumber = uint64(*request.Params.PageNumber)
+	}
+	if request.Params.PageSize != nil {
+		params.PageSize = uint64(*request.Params.PageSize)
+	}
+
+	list, err := g.uc.GetGameList(ctx, params)
+	if errors.Is(err, apperrors.ErrForbidden) {
+		return gen.ListGames403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx, err)}, nil
+	}
+	if errors.Is(err, apperrors.ErrInvalidGameRange) || errors.Is(err, apperrors.ErrInvalidGamePageNumber) ||
+		errors.Is(err, apperrors.ErrInvalidGamePageSize) {
+		return gen.ListGames400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: badRequest(ctx, err)}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return gen.ListGames200JSONResponse(list), nil
+}
+
+// UpdateGame implements gen.StrictServerInterface.
+func (g *GamesServerImpl) UpdateGame(ctx context.Context, request gen.UpdateGameRequestObject) (gen.UpdateGameResponseObject, error) {
+	updated, err := g.uc.UpdateGame(ctx, request.Id, request.Body)
+	if errors.Is(err, apperrors.ErrForbidden) {
+		return gen.UpdateGame403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx, err)}, nil
+	}
+	if errors.Is(err, apperrors.ErrGameNotFound) {
+		return gen.UpdateGame404ApplicationProblemPlusJSONResponse{NotFoundApplicationProblemPlusJSONResponse: notFound(ctx, err)}, nil
+	}
+	if errors.Is(err, apperrors.ErrTeamNotFound) || isInvalidField(err) {
+		return gen.UpdateGame400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: badRequest(ctx, err)}, nil
+	}
+	if isConflict(err) {
+		return gen.UpdateGame409ApplicationProblemPlusJSONResponse{ConflictApplicationProblemPlusJSONResponse: conflict(ctx, err)}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return gen.UpdateGame200JSONResponse(*updated), nil
+}
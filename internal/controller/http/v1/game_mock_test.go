@@ -0,0 +1,39 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/arsnazarenko/devops-basketball/api/gen"
+	"github.com/arsnazarenko/devops-basketball/internal/usecase"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockGame is a mock implementation of usecase.Game interface
+type MockGame struct {
+	mock.Mock
+}
+
+func (m *MockGame) CreateGame(ctx context.Context, game *gen.GameCreate) (*gen.Game, error) {
+	args := m.Called(ctx, game)
+	return args.Get(0).(*gen.Game), args.Error(1)
+}
+
+func (m *MockGame) UpdateGame(ctx context.Context, gameID int64, game *gen.GameUpdate) (*gen.Game, error) {
+	args := m.Called(ctx, gameID, game)
+	return args.Get(0).(*gen.Game), args.Error(1)
+}
+
+func (m *MockGame) DeleteGame(ctx context.Context, gameID int64) error {
+	args := m.Called(ctx, gameID)
+	return args.Error(0)
+}
+
+func (m *MockGame) GetGame(ctx context.Context, gameID int64) (*gen.Game, error) {
+	args := m.Called(ctx, gameID)
+	return args.Get(0).(*gen.Game), args.Error(1)
+}
+
+func (m *MockGame) GetGameList(ctx context.Context, params usecase.GameListParams) ([]gen.Game, error) {
+	args := m.Called(ctx, params)
+	return args.Get(0).([]gen.Game), args.Error(1)
+}
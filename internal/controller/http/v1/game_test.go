@@ -0,0 +1,249 @@
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/arsnazarenko/devops-basketball/api/gen"
+	"github.com/arsnazarenko/devops-basketball/internal/apperrors"
+	"github.com/arsnazarenko/devops-basketball/internal/usecase"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupGameTestServer(mockUC *MockGame) *httptest.Server {
+	return newTestServer(&MockPlayer{}, &MockTeam{}, mockUC)
+}
+
+func TestCreateGame(t *testing.T) {
+	mockUC := &MockGame{}
+	server := setupGameTestServer(mockUC)
+	defer server.Close()
+
+	scheduledAt := time.Date(2025, 3, 14, 19, 30, 0, 0, time.UTC)
+
+	t.Run("success", func(t *testing.T) {
+		gameCreate := &gen.GameCreate{HomeTeamId: 1, AwayTeamId: 2, ScheduledAt: scheduledAt, Venue: "Crypto.com Arena"}
+		expectedGame := &gen.Game{Id: 7, HomeTeamId: 1, AwayTeamId: 2, ScheduledAt: scheduledAt, Venue: "Crypto.com Arena", Status: gen.Scheduled}
+
+		mockUC.On("CreateGame", mock.Anything, gameCreate).Return(expectedGame, nil).Once()
+
+		body, _ := json.Marshal(gameCreate)
+		resp, err := http.Post(server.URL+"/games", "application/json", bytes.NewReader(body))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		var response gen.Game
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&response))
+		require.Equal(t, *expectedGame, response)
+
+		mockUC.AssertExpectations(t)
+	})
+
+	t.Run("invalid status", func(t *testing.T) {
+		body := []byte(`{"homeTeamId":1,"awayTeamId":2,"scheduledAt":"2025-03-14T19:30:00Z","venue":"Arena","status":"cancelled"}`)
+		resp, err := http.Post(server.URL+"/games", "application/json", bytes.NewReader(body))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("unknown team", func(t *testing.T) {
+		teamErr := &apperrors.FieldError{Field: "awayTeamId", Constraint: "games_away_team_id_fkey", Err: apperrors.ErrTeamNotFound}
+		mockUC.On("CreateGame", mock.Anything, mock.Anything).Return((*gen.Game)(nil), teamErr).Once()
+
+		body := []byte(`{"homeTeamId":1,"awayTeamId":99,"scheduledAt":"2025-03-14T19:30:00Z","venue":"Arena"}`)
+		resp, err := http.Post(server.URL+"/games", "application/json", bytes.NewReader(body))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		p := decodeProblem(t, resp)
+		require.Equal(t, "urn:problem:team-not-found", p.Type)
+		require.Equal(t, http.StatusBadRequest, p.Status)
+		require.NotNil(t, p.Errors)
+		require.Equal(t, "awayTeamId", (*p.Errors)[0].Field)
+
+		mockUC.AssertExpectations(t)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		mockUC.On("CreateGame", mock.Anything, mock.Anything).Return((*gen.Game)(nil), apperrors.ErrForbidden).Once()
+
+		body := []byte(`{"homeTeamId":1,"awayTeamId":2,"scheduledAt":"2025-03-14T19:30:00Z","venue":"Arena"}`)
+		resp, err := http.Post(server.URL+"/games", "application/json", bytes.NewReader(body))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+		mockUC.AssertExpectations(t)
+	})
+}
+
+func TestListGames(t *testing.T) {
+	mockUC := &MockGame{}
+	server := setupGameTestServer(mockUC)
+	defer server.Close()
+
+	t.Run("schedule of a team", func(t *testing.T) {
+		teamID := int64(1)
+		from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+		games := []gen.Game{{Id: 7, HomeTeamId: 2, AwayTeamId: 1, ScheduledAt: from.Add(time.Hour), Venue: "Arena", Status: gen.Scheduled}}
+
+		mockUC.On("GetGameList", mock.Anything, usecase.GameListParams{
+			TeamID:     &teamID,
+			From:       &from,
+			To:         &to,
+			PageSize:   10,
+			PageNumber: 2,
+		}).Return(games, nil).Once()
+
+		resp, err := http.Get(server.URL + "/games?team_id=1&from=2025-03-01T00:00:00Z&to=2025-04-01T00:00:00Z&page_size=10&page_number=2")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var response []gen.Game
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&response))
+		require.Equal(t, games, response)
+
+		mockUC.AssertExpectations(t)
+	})
+
+	t.Run("invalid range", func(t *testing.T) {
+		mockUC.On("GetGameList", mock.Anything, mock.Anything).Return([]gen.Game(nil), apperrors.ErrInvalidGameRange).Once()
+
+		resp, err := http.Get(server.URL + "/games?from=2025-04-01T00:00:00Z&to=2025-03-01T00:00:00Z")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		var problem gen.Problem
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&problem))
+		require.Equal(t, "urn:problem:invalid-game-range", problem.Type)
+
+		mockUC.AssertExpectations(t)
+	})
+}
+
+func TestGetGame(t *testing.T) {
+	mockUC := &MockGame{}
+	server := setupGameTestServer(mockUC)
+	defer server.Close()
+
+	mockUC.On("GetGame", mock.Anything, int64(999)).Return((*gen.Game)(nil), apperrors.ErrGameNotFound).Once()
+
+	resp, err := http.Get(server.URL + "/games/999")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	var problem gen.Problem
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&problem))
+	require.Equal(t, "urn:problem:game-not-found", problem.Type)
+
+	mockUC.AssertExpectations(t)
+}
+
+func TestUpdateGame(t *testing.T) {
+	mockUC := &MockGame{}
+	server := setupGameTestServer(mockUC)
+	defer server.Close()
+
+	homeScore, awayScore := int32(112), int32(108)
+	gameUpdate := &gen.GameUpdate{
+		HomeTeamId:  1,
+		AwayTeamId:  2,
+		ScheduledAt: time.Date(2025, 3, 14, 19, 30, 0, 0, time.UTC),
+		Venue:       "Crypto.com Arena",
+		Status:      gen.Final,
+		HomeScore:   &homeScore,
+		AwayScore:   &awayScore,
+	}
+
+	t.Run("final score", func(t *testing.T) {
+		expectedGame := &gen.Game{Id: 7, HomeTeamId: 1, AwayTeamId: 2, ScheduledAt: gameUpdate.ScheduledAt,
+			Venue: gameUpdate.Venue, Status: gen.Final, HomeScore: &homeScore, AwayScore: &awayScore}
+		mockUC.On("UpdateGame", mock.Anything, int64(7), gameUpdate).Return(expectedGame, nil).Once()
+
+		body, _ := json.Marshal(gameUpdate)
+		req, _ := http.NewRequest(http.MethodPut, server.URL+"/games/7", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var response gen.Game
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&response))
+		require.Equal(t, *expectedGame, response)
+
+		mockUC.AssertExpectations(t)
+	})
+
+	t.Run("invalid score", func(t *testing.T) {
+		fieldErr := &apperrors.FieldError{Field: "homeScore", Err: apperrors.ErrInvalidField}
+		mockUC.On("UpdateGame", mock.Anything, int64(7), mock.Anything).Return((*gen.Game)(nil), fieldErr).Once()
+
+		body, _ := json.Marshal(gameUpdate)
+		req, _ := http.NewRequest(http.MethodPut, server.URL+"/games/7", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		var problem gen.Problem
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&problem))
+		require.NotNil(t, problem.Errors)
+		require.Equal(t, "homeScore", (*problem.Errors)[0].Field)
+
+		mockUC.AssertExpectations(t)
+	})
+}
+
+func TestDeleteGame(t *testing.T) {
+	mockUC := &MockGame{}
+	server := setupGameTestServer(mockUC)
+	defer server.Close()
+
+	t.Run("success", func(t *testing.T) {
+		mockUC.On("DeleteGame", mock.Anything, int64(7)).Return(nil).Once()
+
+		req, _ := http.NewRequest(http.MethodDelete, server.URL+"/games/7", nil)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		mockUC.AssertExpectations(t)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockUC.On("DeleteGame", mock.Anything, int64(999)).Return(apperrors.ErrGameNotFound).Once()
+
+		req, _ := http.NewRequest(http.MethodDelete, server.URL+"/games/999", nil)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+		mockUC.AssertExpectations(t)
+	})
+}
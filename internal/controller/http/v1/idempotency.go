@@ -0,0 +1,181 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/arsnazarenko/devops-basketball/internal/apperrors"
+	"github.com/arsnazarenko/devops-basketball/internal/auth"
+	"github.com/arsnazarenko/devops-basketball/internal/controller/http/middleware"
+	"github.com/arsnazarenko/devops-basketball/internal/usecase"
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+)
+
+const (
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader marks a response replayed for a retried request
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength = 255
+
+	// idempotentPath is the path of the only operation, POST /players, made idempotent
+	idempotentPath = "/players"
+
+	// completeAttempts is how often storing a sent response is tried
+	completeAttempts = 3
+	completeBackoff  = 50 * time.Millisecond
+)
+
+// replayedHeaders are the response headers stored with an idempotent response.
+var replayedHeaders = []string{"Content-Type", "Location", "ETag"}
+
+// Idempotency makes POST /players requests carrying an Idempotency-Key header safe
+// to retry. Other requests pass through.
+// The first request with a key runs and its successful response is stored for ttl;
+// retries with the same key and request get the stored response replayed. A retry
+// with a different query, content type or body is rejected with 422, one racing
+// the first request with 409.
+// Failed requests release the key, so they can be retried. A successful
+// response keeps the key claimed even when it cannot be stored, so that a
+// retry does not run the request again. A key whose request has not completed
+// within lease, e.g. because the instance running it crashed, is claimed again.
+//
+// Keys are scoped by the authenticated caller, so it must run after authentication.
+func Idempotency(store usecase.IdempotencyRp, ttl, lease time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(IdempotencyKeyHeader)
+			if r.Method != http.MethodPost || r.URL.Path != idempotentPath || key == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			ctx := context.WithValue(r.Context(), requestPathKey{}, r.URL.Path)
+			if !validIdempotencyKey(key) {
+				writeProblem(w, newProblem(ctx, apperrors.ErrInvalidIdempotencyKey))
+				return
+			}
+
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				middleware.RecordError(ctx, err)
+				writeProblem(w, newProblem(ctx, err))
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			hash := requestHash(r, body)
+			scope := idempotencyScope(ctx)
+
+			claimed, earlier, err := store.ReserveIdempotencyKey(ctx, scope, key, hash, ttl, lease)
+			if err != nil {
+				middleware.RecordError(ctx, err)
+				writeProblem(w, newProblem(ctx, err))
+				return
+			}
+			if earlier != nil {
+				switch {
+				case !bytes.Equal(earlier.Hash, hash):
+					writeProblem(w, newProblem(ctx, apperrors.ErrIdempotencyKeyReused))
+				case earlier.Response == nil:
+					writeProblem(w, newProblem(ctx, apperrors.ErrIdempotencyKeyInProgress))
+				default:
+					replay(w, earlier.Response)
+				}
+				return
+			}
+
+			completed := false
+			// the key is released even if the handler panics, and after the client went away
+			storeCtx := context.WithoutCancel(ctx)
+			defer func() {
+				if completed {
+					return
+				}
+				if err := store.ReleaseIdempotencyKey(storeCtx, scope, key, claimed); err != nil {
+					middleware.RecordError(ctx, err)
+				}
+			}()
+
+			var recorded bytes.Buffer
+			ww := chimiddleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			ww.Tee(&recorded)
+			next.ServeHTTP(ww, r)
+
+			status := ww.Status()
+			if status < http.StatusOK || status >= http.StatusMultipleChoices {
+				return
+			}
+			completed = true
+			response := &usecase.IdempotentResponse{
+				Status: status,
+				Header: map[string]string{},
+				Body:   recorded.Bytes(),
+			}
+			for _, name := range replayedHeaders {
+				if v := ww.Header().Get(name); v != "" {
+					response.Header[name] = v
+				}
+			}
+			if err := completeIdempotencyKey(storeCtx, store, scope, key, claimed, response); err != nil {
+				middleware.RecordError(ctx, err)
+			}
+		})
+	}
+}
+
+// completeIdempotencyKey stores the response to the request that claimed key,
+// retrying a few times: the response was already sent and must not be lost.
+func completeIdempotencyKey(ctx context.Context, store usecase.IdempotencyRp, scope, key string, claimed time.Time, response *usecase.IdempotentResponse) error {
+	for attempt := 1; ; attempt++ {
+		err := store.CompleteIdempotencyKey(ctx, scope, key, claimed, response)
+		if err == nil || attempt == completeAttempts {
+			return err
+		}
+		time.Sleep(time.Duration(attempt) * completeBackoff)
+	}
+}
+
+// replay writes a stored response.
+func replay(w http.ResponseWriter, response *usecase.IdempotentResponse) {
+	for name, v := range response.Header {
+		w.Header().Set(name, v)
+	}
+	w.Header().Set(IdempotentReplayedHeader, "true")
+	w.WriteHeader(response.Status)
+	_, _ = w.Write(response.Body)
+}
+
+// requestHash identifies a request by its method, path, query, content type
+// and body.
+func requestHash(r *http.Request, body []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte(r.Method + " " + r.URL.Path + "?" + r.URL.RawQuery + "\n"))
+	h.Write([]byte(r.Header.Get("Content-Type") + "\n"))
+	h.Write(body)
+	return h.Sum(nil)
+}
+
+// idempotencyScope returns the caller the keys belong to, empty without authentication.
+func idempotencyScope(ctx context.Context) string {
+	p, ok := auth.PrincipalFrom(ctx)
+	if !ok {
+		return ""
+	}
+	return p.Method + ":" + p.Subject
+}
+
+// validIdempotencyKey reports whether key is 1 to 255 printable ASCII characters.
+func validIdempotencyKey(key string) bool {
+	if len(key) == 0 || len(key) > maxIdempotencyKeyLength {
+		return false
+	}
+	for i := 0; i < len(key); i++ {
+		if key[i] < 0x20 || key[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
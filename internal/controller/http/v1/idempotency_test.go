@@ -0,0 +1,201 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/arsnazarenko/devops-basketball/internal/usecase"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryIdempotencyRp keeps idempotency keys in memory and never expires them.
+// Completing a key fails with completeErr when it is set.
+type memoryIdempotencyRp struct {
+	mu          sync.Mutex
+	keys        map[string]*usecase.IdempotentRequest
+	claims      map[string]time.Time
+	completeErr error
+	completes   int
+}
+
+func newMemoryIdempotencyRp() *memoryIdempotencyRp {
+	return &memoryIdempotencyRp{keys: map[string]*usecase.IdempotentRequest{}, claims: map[string]time.Time{}}
+}
+
+func (m *memoryIdempotencyRp) ReserveIdempotencyKey(_ context.Context, scope, key string, hash []byte, _, _ time.Duration) (time.Time, *usecase.IdempotentRequest, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if earlier, ok := m.keys[scope+"/"+key]; ok {
+		return time.Time{}, earlier, nil
+	}
+	claimed := time.Now()
+	m.keys[scope+"/"+key] = &usecase.IdempotentRequest{Hash: hash}
+	m.claims[scope+"/"+key] = claimed
+	return claimed, nil, nil
+}
+
+func (m *memoryIdempotencyRp) CompleteIdempotencyKey(_ context.Context, scope, key string, claimed time.Time, response *usecase.IdempotentResponse) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.completes++
+	if m.completeErr != nil {
+		return m.completeErr
+	}
+	if m.claims[scope+"/"+key].Equal(claimed) {
+		m.keys[scope+"/"+key].Response = response
+	}
+	return nil
+}
+
+func (m *memoryIdempotencyRp) ReleaseIdempotencyKey(_ context.Context, scope, key string, claimed time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.claims[scope+"/"+key].Equal(claimed) {
+		delete(m.keys, scope+"/"+key)
+		delete(m.claims, scope+"/"+key)
+	}
+	return nil
+}
+
+func (m *memoryIdempotencyRp) DeleteExpiredIdempotencyKeys(context.Context) (int64, error) {
+	return 0, nil
+}
+
+func TestIdempotency(t *testing.T) {
+	store := newMemoryIdempotencyRp()
+	calls := 0
+	status := http.StatusCreated
+	handler := Idempotency(store, time.Hour, time.Minute)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write(body)
+	}))
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	post := func(t *testing.T, key, body string) *http.Response {
+		req, _ := http.NewRequest(http.MethodPost, server.URL+"/players", strings.NewReader(body))
+		if key != "" {
+			req.Header.Set(IdempotencyKeyHeader, key)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		return resp
+	}
+	read := func(t *testing.T, resp *http.Response) string {
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return string(body)
+	}
+
+	t.Run("retry replays the first response", func(t *testing.T) {
+		first := post(t, "k1", `{"id":1}`)
+		require.Equal(t, http.StatusCreated, first.StatusCode)
+		require.Equal(t, `{"id":1}`, read(t, first))
+
+		retry := post(t, "k1", `{"id":1}`)
+		require.Equal(t, http.StatusCreated, retry.StatusCode)
+		require.Equal(t, "true", retry.Header.Get(IdempotentReplayedHeader))
+		require.Equal(t, "application/json", retry.Header.Get("Content-Type"))
+		require.Equal(t, `{"id":1}`, read(t, retry))
+		require.Equal(t, 1, calls)
+	})
+
+	t.Run("different body is rejected", func(t *testing.T) {
+		resp := post(t, "k1", `{"id":2}`)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+		require.Equal(t, "urn:problem:idempotency-key-reused", decodeProblem(t, resp).Type)
+	})
+
+	t.Run("different query is rejected", func(t *testing.T) {
+		read(t, post(t, "k4", `{}`))
+
+		req, _ := http.NewRequest(http.MethodPost, server.URL+"/players?dry_run=true", strings.NewReader(`{}`))
+		req.Header.Set(IdempotencyKeyHeader, "k4")
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	})
+
+	t.Run("different content type is rejected", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, server.URL+"/players", strings.NewReader(`{}`))
+		req.Header.Set(IdempotencyKeyHeader, "k4")
+		req.Header.Set("Content-Type", "text/csv")
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	})
+
+	t.Run("request in progress", func(t *testing.T) {
+		store.keys["/busy"] = &usecase.IdempotentRequest{Hash: requestHash(httptest.NewRequest(http.MethodPost, "/players", nil), []byte("{}"))}
+		resp := post(t, "busy", `{}`)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusConflict, resp.StatusCode)
+	})
+
+	t.Run("failed request releases the key", func(t *testing.T) {
+		calls = 0
+		status = http.StatusBadRequest
+		read(t, post(t, "k2", `{}`))
+		status = http.StatusCreated
+		resp := post(t, "k2", `{}`)
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		require.Empty(t, resp.Header.Get(IdempotentReplayedHeader))
+		read(t, resp)
+		require.Equal(t, 2, calls)
+	})
+
+	t.Run("sent response keeps the key when it cannot be stored", func(t *testing.T) {
+		calls, store.completes = 0, 0
+		store.completeErr = errors.New("connection refused")
+		defer func() { store.completeErr = nil }()
+		resp := post(t, "k3", `{}`)
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+		read(t, resp)
+		require.Equal(t, completeAttempts, store.completes)
+
+		retry := post(t, "k3", `{}`)
+		defer retry.Body.Close()
+		require.Equal(t, http.StatusConflict, retry.StatusCode)
+		require.Equal(t, 1, calls)
+	})
+
+	t.Run("invalid key", func(t *testing.T) {
+		resp := post(t, strings.Repeat("k", 256), `{}`)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("other operations run every time", func(t *testing.T) {
+		calls = 0
+		for range 2 {
+			req, _ := http.NewRequest(http.MethodPost, server.URL+"/teams", strings.NewReader(`{}`))
+			req.Header.Set(IdempotencyKeyHeader, "k5")
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			require.Empty(t, resp.Header.Get(IdempotentReplayedHeader))
+			read(t, resp)
+		}
+		require.Equal(t, 2, calls)
+	})
+
+	t.Run("requests without a key run every time", func(t *testing.T) {
+		calls = 0
+		read(t, post(t, "", `{}`))
+		read(t, post(t, "", `{}`))
+		require.Equal(t, 2, calls)
+	})
+}
@@ -10,12 +10,11 @@ import (
 )
 
 const (
-	defaultPageSize   = 50
-	defaultPageNumber = 1
+	defaultPageSize    = 50
+	defaultPageNumber  = 1
+	defaultSearchLimit = 20
 )
 
-var _ gen.StrictServerInterface = (*PlayersServerImpl)(nil)
-
 type PlayersServerImpl struct {
 	uc usecase.Player
 }
@@ -29,8 +28,14 @@ func NewPlayersServerImpl(uc usecase.Player) *PlayersServerImpl {
 // CreatePlayer implements gen.StrictServerInterface.
 func (p *PlayersServerImpl) CreatePlayer(ctx context.Context, request gen.CreatePlayerRequestObject) (gen.CreatePlayerResponseObject, error) {
 	created, err := p.uc.CreatePlayer(ctx, request.Body)
-	if errors.Is(err, apperrors.ErrTeamNotFound) {
-		return gen.CreatePlayer400Response{}, nil
+	if errors.Is(err, apperrors.ErrForbidden) {
+		return gen.CreatePlayer403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx, err)}, nil
+	}
+	if errors.Is(err, apperrors.ErrTeamNotFound) || isInvalidField(err) {
+		return gen.CreatePlayer400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: badRequest(ctx, err)}, nil
+	}
+	if isConflict(err) {
+		return gen.CreatePlayer409ApplicationProblemPlusJSONResponse{ConflictApplicationProblemPlusJSONResponse: conflict(ctx, err)}, nil
 	}
 	if err != nil {
 		return nil, err
@@ -40,9 +45,21 @@ func (p *PlayersServerImpl) CreatePlayer(ctx context.Context, request gen.Create
 
 // DeletePlayer implements gen.StrictServerInterface.
 func (p *PlayersServerImpl) DeletePlayer(ctx context.Context, request gen.DeletePlayerRequestObject) (gen.DeletePlayerResponseObject, error) {
-	err := p.uc.DeletePlayer(ctx, request.Id)
+	version, err := ifMatchVersion(request.Params.IfMatch)
+	if err == nil {
+		err = p.uc.DeletePlayer(ctx, request.Id, version)
+	}
+	if errors.Is(err, apperrors.ErrPreconditionRequired) {
+		return gen.DeletePlayer428ApplicationProblemPlusJSONResponse{PreconditionRequiredApplicationProblemPlusJSONResponse: preconditionRequired(ctx, err)}, nil
+	}
+	if errors.Is(err, apperrors.ErrVersionMismatch) {
+		return gen.DeletePlayer412ApplicationProblemPlusJSONResponse{PreconditionFailedApplicationProblemPlusJSONResponse: preconditionFailed(ctx, err)}, nil
+	}
+	if errors.Is(err, apperrors.ErrForbidden) {
+		return gen.DeletePlayer403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx, err)}, nil
+	}
 	if errors.Is(err, apperrors.ErrPlayerNotFound) {
-		return gen.DeletePlayer404Response{}, nil
+		return gen.DeletePlayer404ApplicationProblemPlusJSONResponse{NotFoundApplicationProblemPlusJSONResponse: notFound(ctx, err)}, nil
 	}
 	if err != nil {
 		return nil, err // internal Server Error (500)
@@ -50,53 +67,119 @@ func (p *PlayersServerImpl) DeletePlayer(ctx context.Context, request gen.Delete
 	return gen.DeletePlayer204Response{}, nil
 }
 
+// RestorePlayer implements gen.StrictServerInterface.
+func (p *PlayersServerImpl) RestorePlayer(ctx context.Context, request gen.RestorePlayerRequestObject) (gen.RestorePlayerResponseObject, error) {
+	restored, err := p.uc.RestorePlayer(ctx, request.Id)
+	if errors.Is(err, apperrors.ErrForbidden) {
+		return gen.RestorePlayer403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx, err)}, nil
+	}
+	if errors.Is(err, apperrors.ErrPlayerNotFound) {
+		return gen.RestorePlayer404ApplicationProblemPlusJSONResponse{NotFoundApplicationProblemPlusJSONResponse: notFound(ctx, err)}, nil
+	}
+	if errors.Is(err, apperrors.ErrPlayerNotDeleted) {
+		return gen.RestorePlayer409ApplicationProblemPlusJSONResponse{ConflictApplicationProblemPlusJSONResponse: conflict(ctx, err)}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return gen.RestorePlayer200JSONResponse(*restored), nil
+}
+
 // GetPlayer implements gen.StrictServerInterface.
+// A player matching If-None-Match is answered with 304 Not Modified.
 func (p *PlayersServerImpl) GetPlayer(ctx context.Context, request gen.GetPlayerRequestObject) (gen.GetPlayerResponseObject, error) {
 	player, err := p.uc.GetPlayer(ctx, request.Id)
+	if errors.Is(err, apperrors.ErrForbidden) {
+		return gen.GetPlayer403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx, err)}, nil
+	}
 	if errors.Is(err, apperrors.ErrPlayerNotFound) {
-		return gen.GetPlayer404Response{}, nil
+		return gen.GetPlayer404ApplicationProblemPlusJSONResponse{NotFoundApplicationProblemPlusJSONResponse: notFound(ctx, err)}, nil
 	}
 	if err != nil {
 		return nil, err
 	}
-	return gen.GetPlayer200JSONResponse(*player), nil
+	etag := playerETag(player.Version)
+	if noneMatch(request.Params.IfNoneMatch, etag) {
+		return gen.GetPlayer304Response{Headers: gen.GetPlayer304ResponseHeaders{ETag: etag}}, nil
+	}
+	return gen.GetPlayer200JSONResponse{Body: *player, Headers: gen.GetPlayer200ResponseHeaders{ETag: etag}}, nil
 }
 
-// ListPlayers implements gen.StrictServerInterface.
-func (p *PlayersServerImpl) ListPlayers(ctx context.Context, request gen.ListPlayersRequestObject) (gen.ListPlayersResponseObject, error) {
-	var (
-		pageSize   uint64 = defaultPageSize
-		pageNumber uint64 = defaultPageNumber
-	)
-
-	if request.Params.PageNumber != nil {
-		pageNumber = uint64(*request.Params.PageNumber)
+// PatchPlayer implements gen.StrictServerInterface.
+func (p *PlayersServerImpl) PatchPlayer(ctx context.Context, request gen.PatchPlayerRequestObject) (gen.PatchPlayerResponseObject, error) {
+	var updated *gen.Player
+	version, err := ifMatchVersion(request.Params.IfMatch)
+	if err == nil {
+		updated, err = p.uc.UpdatePlayer(ctx, request.Id, version, request.Body)
+	}
+	if errors.Is(err, apperrors.ErrPreconditionRequired) {
+		return gen.PatchPlayer428ApplicationProblemPlusJSONResponse{PreconditionRequiredApplicationProblemPlusJSONResponse: preconditionRequired(ctx, err)}, nil
+	}
+	if errors.Is(err, apperrors.ErrVersionMismatch) {
+		return gen.PatchPlayer412ApplicationProblemPlusJSONResponse{PreconditionFailedApplicationProblemPlusJSONResponse: preconditionFailed(ctx, err)}, nil
+	}
+	if errors.Is(err, apperrors.ErrForbidden) {
+		return gen.PatchPlayer403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx, err)}, nil
+	}
+	if errors.Is(err, apperrors.ErrPlayerNotFound) {
+		return gen.PatchPlayer404ApplicationProblemPlusJSONResponse{NotFoundApplicationProblemPlusJSONResponse: notFound(ctx, err)}, nil
+	}
+	if errors.Is(err, apperrors.ErrTeamNotFound) || isInvalidField(err) {
+		return gen.PatchPlayer400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: badRequest(ctx, err)}, nil
+	}
+	if isConflict(err) {
+		return gen.PatchPlayer409ApplicationProblemPlusJSONResponse{ConflictApplicationProblemPlusJSONResponse: conflict(ctx, err)}, nil
+	}
+	if err != nil {
+		return nil, err
 	}
-	if request.Params.PageSize != nil {
-		pageSize = uint64(*request.Params.PageSize)
+	return gen.PatchPlayer200JSONResponse{Body: *updated, Headers: gen.PatchPlayer200ResponseHeaders{ETag: playerETag(updated.Version)}}, nil
+}
+
+// SearchPlayers implements gen.StrictServerInterface.
+func (p *PlayersServerImpl) SearchPlayers(ctx context.Context, request gen.SearchPlayersRequestObject) (gen.SearchPlayersResponseObject, error) {
+	var limit uint64 = defaultSearchLimit
+	if request.Params.Limit != nil {
+		limit = uint64(*request.Params.Limit)
 	}
 
-	list, err := p.uc.GetPlayerList(ctx, pageSize, pageNumber)
-	if errors.Is(err, apperrors.ErrInvalidPlayerPageNumber) || errors.Is(err, apperrors.ErrInvalidPlayerPageSize) {
-		return gen.ListPlayers400Response{}, nil
+	results, err := p.uc.SearchPlayers(ctx, request.Params.Q, limit)
+	if errors.Is(err, apperrors.ErrForbidden) {
+		return gen.SearchPlayers403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx, err)}, nil
 	}
 	if err != nil {
 		return nil, err
 	}
-	return gen.ListPlayers200JSONResponse(list), nil
+	return gen.SearchPlayers200JSONResponse(results), nil
 }
 
 // UpdatePlayer implements gen.StrictServerInterface.
 func (p *PlayersServerImpl) UpdatePlayer(ctx context.Context, request gen.UpdatePlayerRequestObject) (gen.UpdatePlayerResponseObject, error) {
-	updated, err := p.uc.UpdatePlayer(ctx, request.Id, request.Body)
+	var updated *gen.Player
+	version, err := ifMatchVersion(request.Params.IfMatch)
+	if err == nil {
+		updated, err = p.uc.UpdatePlayer(ctx, request.Id, version, request.Body)
+	}
+	if errors.Is(err, apperrors.ErrPreconditionRequired) {
+		return gen.UpdatePlayer428ApplicationProblemPlusJSONResponse{PreconditionRequiredApplicationProblemPlusJSONResponse: preconditionRequired(ctx, err)}, nil
+	}
+	if errors.Is(err, apperrors.ErrVersionMismatch) {
+		return gen.UpdatePlayer412ApplicationProblemPlusJSONResponse{PreconditionFailedApplicationProblemPlusJSONResponse: preconditionFailed(ctx, err)}, nil
+	}
+	if errors.Is(err, apperrors.ErrForbidden) {
+		return gen.UpdatePlayer403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx, err)}, nil
+	}
 	if errors.Is(err, apperrors.ErrPlayerNotFound) {
-		return gen.UpdatePlayer404Response{}, nil
+		return gen.UpdatePlayer404ApplicationProblemPlusJSONResponse{NotFoundApplicationProblemPlusJSONResponse: notFound(ctx, err)}, nil
+	}
+	if errors.Is(err, apperrors.ErrTeamNotFound) || isInvalidField(err) {
+		return gen.UpdatePlayer400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: badRequest(ctx, err)}, nil
 	}
-	if errors.Is(err, apperrors.ErrTeamNotFound) {
-		return gen.UpdatePlayer400Response{}, nil
+	if isConflict(err) {
+		return gen.UpdatePlayer409ApplicationProblemPlusJSONResponse{ConflictApplicationProblemPlusJSONResponse: conflict(ctx, err)}, nil
 	}
 	if err != nil {
 		return nil, err
 	}
-	return gen.UpdatePlayer200JSONResponse(*updated), nil
+	return gen.UpdatePlayer200JSONResponse{Body: *updated, Headers: gen.UpdatePlayer200ResponseHeaders{ETag: playerETag(updated.Version)}}, nil
 }
@@ -0,0 +1,101 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/arsnazarenko/devops-basketball/api/gen"
+	"github.com/arsnazarenko/devops-basketball/internal/apperrors"
+	"github.com/arsnazarenko/devops-basketball/internal/controller/http/middleware"
+	"github.com/arsnazarenko/devops-basketball/internal/usecase"
+)
+
+// BatchPlayers implements gen.StrictServerInterface.
+// A batch with an operation lacking a field its op requires is rejected as a whole.
+// Every other failure is reported in the result of the operation it belongs to.
+func (p *PlayersServerImpl) BatchPlayers(ctx context.Context, request gen.BatchPlayersRequestObject) (gen.BatchPlayersResponseObject, error) {
+	mode := gen.Atomic
+	if request.Body.Mode != nil {
+		mode = *request.Body.Mode
+	}
+	ops, fieldErrs := batchOperations(request.Body.Operations)
+	if len(fieldErrs) > 0 {
+		problem := badRequest(ctx, apperrors.ErrInvalidBatchOperation)
+		problem.Errors = &fieldErrs
+		return gen.BatchPlayers400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: problem}, nil
+	}
+
+	done, err := p.uc.BatchPlayers(ctx, ops, mode == gen.Atomic)
+	if err != nil {
+		return nil, err
+	}
+	response := gen.PlayerBatchResponse{Mode: mode, Results: make([]gen.PlayerBatchResult, len(done))}
+	for i, r := range done {
+		response.Results[i] = batchResult(ctx, i, ops[i].Op, r)
+		if r.Err != nil {
+			response.Failed++
+		} else {
+			response.Succeeded++
+		}
+	}
+	return gen.BatchPlayers200JSONResponse(response), nil
+}
+
+// batchOperations converts the operations of a batch request. It returns an
+// error for every field an operation lacks.
+func batchOperations(items []gen.PlayerBatchOperation) ([]usecase.PlayerOperation, []gen.FieldError) {
+	ops := make([]usecase.PlayerOperation, len(items))
+	var fieldErrs []gen.FieldError
+	require := func(i int, field string, ok bool) {
+		if !ok {
+			fieldErrs = append(fieldErrs, gen.FieldError{
+				Field:   fmt.Sprintf("operations/%d/%s", i, field),
+				Message: "required for op " + string(items[i].Op),
+			})
+		}
+	}
+	for i, item := range items {
+		op := usecase.PlayerOperation{Op: item.Op, Create: item.Player, Update: item.Changes}
+		switch item.Op {
+		case gen.PlayerBatchOperationOpCreate:
+			require(i, "player", item.Player != nil)
+		case gen.PlayerBatchOperationOpUpdate:
+			require(i, "changes", item.Changes != nil)
+			fallthrough
+		default:
+			require(i, "id", item.Id != nil)
+			require(i, "version", item.Version != nil)
+			if item.Id != nil && item.Version != nil {
+				op.ID, op.Version = *item.Id, *item.Version
+			}
+		}
+		ops[i] = op
+	}
+	return ops, fieldErrs
+}
+
+// batchResult reports the outcome of an operation with the status the single
+// request for it would have had.
+func batchResult(ctx context.Context, index int, op gen.PlayerBatchOperationOp, r usecase.PlayerOperationResult) gen.PlayerBatchResult {
+	if r.Err != nil {
+		problem := newProblem(ctx, r.Err)
+		if errors.Is(r.Err, apperrors.ErrTeamNotFound) {
+			// creates and updates reject an unknown team as an invalid field
+			problem = gen.Problem(badRequest(ctx, r.Err))
+		}
+		if problem.Status == http.StatusInternalServerError {
+			middleware.RecordError(ctx, r.Err)
+		}
+		return gen.PlayerBatchResult{Index: index, Status: problem.Status, Problem: &problem}
+	}
+	status := http.StatusOK
+	switch op {
+	case gen.PlayerBatchOperationOpCreate:
+		status = http.StatusCreated
+	case gen.PlayerBatchOperationOpDelete:
+		status = http.StatusNoContent
+	}
+	return gen.PlayerBatchResult{Index: index, Status: status, Player: r.Player}
+}
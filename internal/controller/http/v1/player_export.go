@@ -0,0 +1,320 @@
+package v1
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/arsnazarenko/devops-basketball/api/gen"
+	"github.com/arsnazarenko/devops-basketball/internal/apperrors"
+	"github.com/arsnazarenko/devops-basketball/internal/usecase"
+	"github.com/arsnazarenko/devops-basketball/pkg/xlsx"
+)
+
+const (
+	xlsxContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+	exportFileName  = "players"
+	exportSheetName = "Players"
+)
+
+// errStreamAborted is wrapped by errors that stop a response body after its status was sent.
+var errStreamAborted = errors.New("stream aborted")
+
+// exportFormats lists the export formats in the order they are preferred
+// when the Accept header does not decide.
+var exportFormats = []struct {
+	format      gen.ExportPlayersParamsFormat
+	contentType string
+}{
+	{gen.Csv, csvContentType},
+	{gen.Ndjson, ndjsonContentType},
+	{gen.Xlsx, xlsxContentType},
+}
+
+// exportEncoder writes the rows of an export in one format. *xlsx.Writer is one.
+type exportEncoder interface {
+	WriteRow(values ...any) error
+	// Close flushes the rows written so far. It does not close the underlying writer.
+	Close() error
+}
+
+// ExportPlayers implements gen.StrictServerInterface.
+// The players are written while they are read from the database. A failure
+// after the first bytes were sent aborts the response.
+func (p *PlayersServerImpl) ExportPlayers(ctx context.Context, request gen.ExportPlayersRequestObject) (gen.ExportPlayersResponseObject, error) {
+	format, ok := exportFormat(request.Params.Format, acceptHeader(ctx))
+	if !ok {
+		return gen.ExportPlayers406ApplicationProblemPlusJSONResponse{NotAcceptableApplicationProblemPlusJSONResponse: gen.NotAcceptableApplicationProblemPlusJSONResponse(newProblem(ctx, apperrors.ErrNotAcceptableExport))}, nil
+	}
+	human := request.Params.Units != nil && *request.Params.Units == gen.Human
+
+	cursor, err := p.uc.ExportPlayers(ctx, playerExportParams(request.Params))
+	if errors.Is(err, apperrors.ErrForbidden) {
+		return gen.ExportPlayers403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx, err)}, nil
+	}
+	if errors.Is(err, apperrors.ErrInvalidPlayerSort) {
+		return gen.ExportPlayers400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: badRequest(ctx, err)}, nil
+	}
+	if errors.Is(err, apperrors.ErrTooManyExports) {
+		return gen.ExportPlayers503ApplicationProblemPlusJSONResponse{ServiceUnavailableApplicationProblemPlusJSONResponse: gen.ServiceUnavailableApplicationProblemPlusJSONResponse(newProblem(ctx, err))}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	body, writer := io.Pipe()
+	go func() {
+		writer.CloseWithError(writePlayerExport(writer, format, cursor, human))
+	}()
+	headers := gen.ExportPlayers200ResponseHeaders{
+		ContentDisposition: mime.FormatMediaType("attachment", map[string]string{"filename": exportFileName + "." + string(format)}),
+	}
+	switch format {
+	case gen.Ndjson:
+		return gen.ExportPlayers200ApplicationxNdjsonResponse{Body: body, Headers: headers}, nil
+	case gen.Xlsx:
+		return gen.ExportPlayers200ApplicationvndOpenxmlformatsOfficedocumentSpreadsheetmlSheetResponse{Body: body, Headers: headers}, nil
+	default:
+		return gen.ExportPlayers200TextcsvResponse{Body: body, Headers: headers}, nil
+	}
+}
+
+// playerExportParams converts export query parameters into usecase.PlayerExportParams.
+func playerExportParams(request gen.ExportPlayersParams) usecase.PlayerExportParams {
+	params := usecase.PlayerExportParams{
+		Filter: usecase.PlayerFilter{
+			TeamID:      request.TeamId,
+			Citizenship: request.Citizenship,
+			MinAge:      request.MinAge,
+			MaxAge:      request.MaxAge,
+			MinHeight:   request.MinHeight,
+			MaxHeight:   request.MaxHeight,
+			MinWeight:   request.MinWeight,
+			MaxWeight:   request.MaxWeight,
+		},
+	}
+	if request.Role != nil {
+		role := string(*request.Role)
+		params.Filter.Role = &role
+	}
+	if request.IncludeDeleted != nil {
+		params.Filter.IncludeDeleted = *request.IncludeDeleted
+	}
+	if request.Sort != nil {
+		params.Sort = parsePlayerSort(*request.Sort)
+	}
+	return params
+}
+
+// acceptHeader returns the Accept header of the request.
+func acceptHeader(ctx context.Context) string {
+	accept, _ := ctx.Value(acceptKey{}).(string)
+	return accept
+}
+
+// exportFormat picks the export format. An explicit format wins, otherwise the
+// format with the highest quality in accept is used. It reports false when
+// accept excludes every format.
+func exportFormat(format *gen.ExportPlayersParamsFormat, accept string) (gen.ExportPlayersParamsFormat, bool) {
+	if format != nil {
+		return *format, true
+	}
+	if strings.TrimSpace(accept) == "" {
+		return gen.Csv, true
+	}
+	var (
+		best    gen.ExportPlayersParamsFormat
+		quality float64
+	)
+	for _, f := range exportFormats {
+		if q := acceptQuality(accept, f.contentType); q > quality {
+			best, quality = f.format, q
+		}
+	}
+	return best, quality > 0
+}
+
+// acceptQuality returns the quality accept gives to contentType. The most
+// specific matching media range decides.
+func acceptQuality(accept, contentType string) float64 {
+	mainType, _, _ := strings.Cut(contentType, "/")
+	quality, specificity := 0.0, -1
+	for _, item := range strings.Split(accept, ",") {
+		mediaRange, params, err := mime.ParseMediaType(strings.TrimSpace(item))
+		if err != nil {
+			continue
+		}
+		var s int
+		switch mediaRange {
+		case contentType:
+			s = 2
+		case mainType + "/*":
+			s = 1
+		case "*/*":
+			s = 0
+		default:
+			continue
+		}
+		if s <= specificity {
+			continue
+		}
+		q := 1.0
+		if value, ok := params["q"]; ok {
+			if q, err = strconv.ParseFloat(value, 64); err != nil {
+				q = 0
+			}
+		}
+		quality, specificity = q, s
+	}
+	return quality
+}
+
+// writePlayerExport writes the players of cursor to w and closes the cursor.
+// Human units write height in meters and weight in kilograms.
+func writePlayerExport(w io.Writer, format gen.ExportPlayersParamsFormat, cursor usecase.PlayerCursor, human bool) error {
+	defer cursor.Close()
+
+	columns := []string{"id", "name", "surname", "age", "height", "weight", "citizenship", "role", "teamId", "version", "deletedAt"}
+	if human {
+		columns[4], columns[5] = "heightM", "weightKg"
+	}
+	enc, err := newExportEncoder(w, format, columns)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errStreamAborted, err)
+	}
+	var player gen.Player
+	for cursor.Next(&player) {
+		if err := enc.WriteRow(exportValues(&player, human)...); err != nil {
+			return fmt.Errorf("%w: %w", errStreamAborted, err)
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return fmt.Errorf("%w: %w", errStreamAborted, err)
+	}
+	if err := enc.Close(); err != nil {
+		return fmt.Errorf("%w: %w", errStreamAborted, err)
+	}
+	return nil
+}
+
+// exportValues returns the cells of player in column order.
+// A player that is not deleted has no deletedAt.
+func exportValues(player *gen.Player, human bool) []any {
+	var height, weight any = player.Height, player.Weight
+	if human {
+		height, weight = float64(player.Height)/1000, float64(player.Weight)/1000
+	}
+	var deletedAt any
+	if player.DeletedAt != nil {
+		deletedAt = player.DeletedAt.UTC().Format(time.RFC3339)
+	}
+	return []any{player.Id, player.Name, player.Surname, player.Age, height, weight,
+		player.Citizenship, string(player.Role), player.TeamId, player.Version, deletedAt}
+}
+
+// newExportEncoder starts an export in format whose rows have the given columns.
+func newExportEncoder(w io.Writer, format gen.ExportPlayersParamsFormat, columns []string) (exportEncoder, error) {
+	switch format {
+	case gen.Ndjson:
+		return &ndjsonEncoder{w: bufio.NewWriter(w), columns: columns}, nil
+	case gen.Xlsx:
+		sheet, err := xlsx.NewWriter(w, exportSheetName)
+		if err != nil {
+			return nil, err
+		}
+		header := make([]any, len(columns))
+		for i, c := range columns {
+			header[i] = c
+		}
+		if err := sheet.WriteRow(header...); err != nil {
+			return nil, err
+		}
+		return sheet, nil
+	default:
+		enc := csvEncoder{csv.NewWriter(w)}
+		if err := enc.w.Write(columns); err != nil {
+			return nil, err
+		}
+		return enc, nil
+	}
+}
+
+// csvEncoder writes one line per row after the header line. Text that a
+// spreadsheet would run as a formula is prefixed with a quote.
+type csvEncoder struct {
+	w *csv.Writer
+}
+
+func (e csvEncoder) WriteRow(values ...any) error {
+	record := make([]string, len(values))
+	for i, v := range values {
+		switch v := v.(type) {
+		case nil:
+		case float64:
+			record[i] = strconv.FormatFloat(v, 'f', -1, 64)
+		case string:
+			record[i] = escapeFormula(v)
+		default:
+			record[i] = fmt.Sprint(v)
+		}
+	}
+	return e.w.Write(record)
+}
+
+func (e csvEncoder) Close() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+// escapeFormula prefixes text starting like a formula with a quote, so that
+// spreadsheets opening the export show it instead of evaluating it.
+func escapeFormula(text string) string {
+	if text != "" && strings.ContainsRune("=+-@\t\r", rune(text[0])) {
+		return "'" + text
+	}
+	return text
+}
+
+// ndjsonEncoder writes every row as a JSON object with the keys in column order.
+// Empty values are left out.
+type ndjsonEncoder struct {
+	w       *bufio.Writer
+	columns []string
+}
+
+func (e *ndjsonEncoder) WriteRow(values ...any) error {
+	e.w.WriteByte('{')
+	first := true
+	for i, v := range values {
+		if v == nil {
+			continue
+		}
+		if !first {
+			e.w.WriteByte(',')
+		}
+		first = false
+		key, _ := json.Marshal(e.columns[i])
+		value, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		e.w.Write(key)
+		e.w.WriteByte(':')
+		e.w.Write(value)
+	}
+	_, err := e.w.WriteString("}\n")
+	return err
+}
+
+func (e *ndjsonEncoder) Close() error {
+	return e.w.Flush()
+}
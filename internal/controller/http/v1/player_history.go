@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/arsnazarenko/devops-basketball/api/gen"
+	"github.com/arsnazarenko/devops-basketball/internal/apperrors"
+	"github.com/arsnazarenko/devops-basketball/internal/usecase"
+)
+
+// GetPlayerHistory implements gen.StrictServerInterface.
+func (p *PlayersServerImpl) GetPlayerHistory(ctx context.Context, request gen.GetPlayerHistoryRequestObject) (gen.GetPlayerHistoryResponseObject, error) {
+	params := usecase.PlayerHistoryParams{PageSize: defaultPageSize}
+	if request.Params.PageSize != nil {
+		params.PageSize = uint64(*request.Params.PageSize)
+	}
+	if request.Params.Cursor != nil {
+		params.Cursor = *request.Params.Cursor
+	}
+
+	page, err := p.uc.GetPlayerHistory(ctx, request.Id, params)
+	if errors.Is(err, apperrors.ErrForbidden) {
+		return gen.GetPlayerHistory403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx, err)}, nil
+	}
+	if errors.Is(err, apperrors.ErrPlayerNotFound) {
+		return gen.GetPlayerHistory404ApplicationProblemPlusJSONResponse{NotFoundApplicationProblemPlusJSONResponse: notFound(ctx, err)}, nil
+	}
+	if errors.Is(err, apperrors.ErrInvalidPlayerPageSize) || errors.Is(err, apperrors.ErrInvalidHistoryCursor) {
+		return gen.GetPlayerHistory400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: badRequest(ctx, err)}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	history := gen.GetPlayerHistory200JSONResponse{Items: page.Entries}
+	if page.NextCursor != "" {
+		history.NextCursor = &page.NextCursor
+	}
+	return history, nil
+}
@@ -0,0 +1,290 @@
+package v1
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/arsnazarenko/devops-basketball/api/gen"
+	"github.com/arsnazarenko/devops-basketball/internal/apperrors"
+	"github.com/arsnazarenko/devops-basketball/internal/usecase"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+const (
+	csvContentType    = "text/csv"
+	ndjsonContentType = "application/x-ndjson"
+
+	// maxNDJSONLine is the longest NDJSON line accepted, in bytes
+	maxNDJSONLine = 1 << 20
+	// maxImportSize is the largest upload accepted, in bytes, as stated by apperrors.ErrImportTooLarge
+	maxImportSize = 32 << 20
+
+	importPath = "/players/import"
+)
+
+// playerCreateSchema returns the PlayerCreate schema of the spec, which import rows are checked against.
+var playerCreateSchema = sync.OnceValues(func() (*openapi3.Schema, error) {
+	swagger, err := gen.GetSwagger()
+	if err != nil {
+		return nil, err
+	}
+	ref, ok := swagger.Components.Schemas["PlayerCreate"]
+	if !ok || ref.Value == nil {
+		return nil, errors.New("spec has no PlayerCreate schema")
+	}
+	return ref.Value, nil
+})
+
+// importParser reads the rows of an upload. Rows that are not valid players
+// are returned as rejections; count is the number of rows read.
+type importParser func(r io.Reader, schema *openapi3.Schema) (rows []usecase.PlayerImportRow, rejected []gen.PlayerImportRejection, count int, err error)
+
+// LimitImportSize rejects import uploads larger than maxImportSize with 413.
+// It must run before the request validator, which reads the whole body.
+func LimitImportSize(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != importPath {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if r.ContentLength > maxImportSize {
+			ctx := context.WithValue(r.Context(), requestPathKey{}, r.URL.Path)
+			writeProblem(w, newProblem(ctx, apperrors.ErrImportTooLarge))
+			return
+		}
+		r.Body = http.MaxBytesReader(w, r.Body, maxImportSize)
+		next.ServeHTTP(w, r)
+	})
+}
+
+// ImportPlayers implements gen.StrictServerInterface.
+func (p *PlayersServerImpl) ImportPlayers(ctx context.Context, request gen.ImportPlayersRequestObject) (gen.ImportPlayersResponseObject, error) {
+	var parse importParser
+	mediaType, _, _ := mime.ParseMediaType(request.ContentType)
+	switch mediaType {
+	case csvContentType:
+		parse = parseCSVImport
+	case ndjsonContentType:
+		parse = parseNDJSONImport
+	default:
+		return gen.ImportPlayers415ApplicationProblemPlusJSONResponse{UnsupportedMediaTypeApplicationProblemPlusJSONResponse: gen.UnsupportedMediaTypeApplicationProblemPlusJSONResponse(newProblem(ctx, apperrors.ErrUnsupportedImportFormat))}, nil
+	}
+	dryRun := request.Params.DryRun != nil && *request.Params.DryRun
+
+	schema, err := playerCreateSchema()
+	if err != nil {
+		return nil, err
+	}
+	rows, rejected, count, err := parse(request.Body, schema)
+	if errors.Is(err, apperrors.ErrInvalidImportHeader) {
+		return gen.ImportPlayers400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: badRequest(ctx, err)}, nil
+	}
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		return gen.ImportPlayers413ApplicationProblemPlusJSONResponse{ContentTooLargeApplicationProblemPlusJSONResponse: gen.ContentTooLargeApplicationProblemPlusJSONResponse(newProblem(ctx, apperrors.ErrImportTooLarge))}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := p.uc.ImportPlayers(ctx, rows, dryRun)
+	if errors.Is(err, apperrors.ErrForbidden) {
+		return gen.ImportPlayers403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx, err)}, nil
+	}
+	if errors.Is(err, apperrors.ErrTeamNotFound) || isInvalidField(err) {
+		return gen.ImportPlayers400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: badRequest(ctx, err)}, nil
+	}
+	if isConflict(err) {
+		return gen.ImportPlayers409ApplicationProblemPlusJSONResponse{ConflictApplicationProblemPlusJSONResponse: conflict(ctx, err)}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rejected = append(rejected, result.Rejected...)
+	slices.SortStableFunc(rejected, func(a, b gen.PlayerImportRejection) int { return a.Line - b.Line })
+	return gen.ImportPlayers200JSONResponse{
+		DryRun:   dryRun,
+		Rows:     count,
+		Imported: result.Imported,
+		Rejected: rejected,
+	}, nil
+}
+
+// parseCSVImport reads a CSV upload whose header names every PlayerCreate field once.
+func parseCSVImport(r io.Reader, schema *openapi3.Schema) ([]usecase.PlayerImportRow, []gen.PlayerImportRejection, int, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil, 0, apperrors.ErrInvalidImportHeader
+		}
+		return nil, nil, 0, errors.Join(apperrors.ErrInvalidImportHeader, err)
+	}
+	if len(header) > 0 {
+		// spreadsheet exports often start with a byte order mark
+		header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	}
+	if !validImportHeader(header, schema) {
+		return nil, nil, 0, apperrors.ErrInvalidImportHeader
+	}
+
+	var (
+		rows     []usecase.PlayerImportRow
+		rejected []gen.PlayerImportRejection
+		count    int
+	)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		count++
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			rejected = append(rejected, gen.PlayerImportRejection{
+				Line:   parseErr.StartLine,
+				Errors: []gen.FieldError{{Field: "", Message: parseErr.Err.Error()}},
+			})
+			continue
+		}
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		line, _ := reader.FieldPos(0)
+
+		value := make(map[string]any, len(record))
+		for i, cell := range record {
+			if cell == "" {
+				continue
+			}
+			value[header[i]] = csvValue(cell, schema.Properties[header[i]].Value)
+		}
+		player, fieldErrs := importPlayer(value, schema)
+		if len(fieldErrs) > 0 {
+			rejected = append(rejected, gen.PlayerImportRejection{Line: line, Errors: fieldErrs})
+			continue
+		}
+		rows = append(rows, usecase.PlayerImportRow{Line: line, Player: *player})
+	}
+	return rows, rejected, count, nil
+}
+
+// validImportHeader reports whether header names every property of schema exactly once.
+func validImportHeader(header []string, schema *openapi3.Schema) bool {
+	if len(header) != len(schema.Properties) {
+		return false
+	}
+	seen := make(map[string]bool, len(header))
+	for _, name := range header {
+		if _, ok := schema.Properties[name]; !ok || seen[name] {
+			return false
+		}
+		seen[name] = true
+	}
+	return true
+}
+
+// csvValue converts a cell to the JSON value the property expects. Cells that
+// do not convert are kept as strings and rejected by the schema.
+func csvValue(cell string, property *openapi3.Schema) any {
+	if property != nil && (property.Type.Is(openapi3.TypeInteger) || property.Type.Is(openapi3.TypeNumber)) {
+		if n, err := strconv.ParseFloat(cell, 64); err == nil {
+			return n
+		}
+	}
+	return cell
+}
+
+// parseNDJSONImport reads an NDJSON upload with one PlayerCreate object per line.
+// Blank lines are skipped, lines longer than maxNDJSONLine are rejected.
+func parseNDJSONImport(r io.Reader, schema *openapi3.Schema) ([]usecase.PlayerImportRow, []gen.PlayerImportRejection, int, error) {
+	var (
+		rows     []usecase.PlayerImportRow
+		rejected []gen.PlayerImportRejection
+		count    int
+	)
+	reader := bufio.NewReaderSize(r, maxNDJSONLine)
+	for line, done := 1, false; !done; line++ {
+		data, err := reader.ReadSlice('\n')
+		if errors.Is(err, bufio.ErrBufferFull) {
+			count++
+			rejected = append(rejected, gen.PlayerImportRejection{
+				Line:   line,
+				Errors: []gen.FieldError{{Field: "", Message: fmt.Sprintf("line is longer than %d bytes", maxNDJSONLine)}},
+			})
+			if done, err = skipLine(reader); err != nil {
+				return nil, nil, 0, err
+			}
+			continue
+		}
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, nil, 0, err
+		}
+		done = err != nil
+		text := strings.TrimSpace(string(data))
+		if text == "" {
+			continue
+		}
+		count++
+		var value any
+		if err := json.Unmarshal([]byte(text), &value); err != nil {
+			rejected = append(rejected, gen.PlayerImportRejection{
+				Line:   line,
+				Errors: []gen.FieldError{{Field: "", Message: err.Error()}},
+			})
+			continue
+		}
+		player, fieldErrs := importPlayer(value, schema)
+		if len(fieldErrs) > 0 {
+			rejected = append(rejected, gen.PlayerImportRejection{Line: line, Errors: fieldErrs})
+			continue
+		}
+		rows = append(rows, usecase.PlayerImportRow{Line: line, Player: *player})
+	}
+	return rows, rejected, count, nil
+}
+
+// skipLine discards the rest of the current line. It reports whether the
+// upload ended with it.
+func skipLine(reader *bufio.Reader) (bool, error) {
+	for {
+		_, err := reader.ReadSlice('\n')
+		switch {
+		case errors.Is(err, bufio.ErrBufferFull):
+		case errors.Is(err, io.EOF):
+			return true, nil
+		default:
+			return false, err
+		}
+	}
+}
+
+// importPlayer checks a decoded JSON value against schema and converts it into
+// a player, or returns every field that violates the schema.
+func importPlayer(value any, schema *openapi3.Schema) (*gen.PlayerCreate, []gen.FieldError) {
+	if err := schema.VisitJSON(value, openapi3.MultiErrors()); err != nil {
+		return nil, causeFieldErrors(nil, "", true, err)
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, []gen.FieldError{{Field: "", Message: err.Error()}}
+	}
+	var player gen.PlayerCreate
+	if err := json.Unmarshal(data, &player); err != nil {
+		return nil, []gen.FieldError{{Field: "", Message: err.Error()}}
+	}
+	return &player, nil
+}
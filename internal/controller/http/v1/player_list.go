@@ -0,0 +1,274 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/arsnazarenko/devops-basketball/api/gen"
+	"github.com/arsnazarenko/devops-basketball/internal/apperrors"
+	"github.com/arsnazarenko/devops-basketball/internal/usecase"
+)
+
+const (
+	playersPath   = "/players"
+	playersV2Path = "/v2/players"
+)
+
+// ListPlayers implements gen.StrictServerInterface.
+func (p *PlayersServerImpl) ListPlayers(ctx context.Context, request gen.ListPlayersRequestObject) (gen.ListPlayersResponseObject, error) {
+	params, err := playerListParams(request.Params)
+	if err != nil {
+		return gen.ListPlayers400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: badRequest(ctx, err)}, nil
+	}
+
+	page, err := p.uc.GetPlayerList(ctx, params)
+	if errors.Is(err, apperrors.ErrForbidden) {
+		return gen.ListPlayers403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx, err)}, nil
+	}
+	if isInvalidPlayerList(err) {
+		return gen.ListPlayers400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: badRequest(ctx, err)}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	links := playerPageLinks(playersPath, request.Params, params, page, nil)
+	return gen.ListPlayers200JSONResponse{
+		Body: page.Players,
+		Headers: gen.ListPlayers200ResponseHeaders{
+			Link: linkHeader(gen.PageLinks{Next: links.Next}),
+		},
+	}, nil
+}
+
+// ListPlayersV2 implements gen.StrictServerInterface.
+func (p *PlayersServerImpl) ListPlayersV2(ctx context.Context, request gen.ListPlayersV2RequestObject) (gen.ListPlayersV2ResponseObject, error) {
+	query := playersV2Query(request.Params)
+	params, err := playerListParams(query)
+	if err != nil {
+		return gen.ListPlayersV2400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: badRequest(ctx, err)}, nil
+	}
+
+	page, err := p.uc.GetPlayerList(ctx, params)
+	if errors.Is(err, apperrors.ErrForbidden) {
+		return gen.ListPlayersV2403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx, err)}, nil
+	}
+	if isInvalidPlayerList(err) {
+		return gen.ListPlayersV2400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: badRequest(ctx, err)}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	total, err := p.uc.CountPlayers(ctx, params.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	links := playerPageLinks(playersV2Path, query, params, page, &total)
+	list := gen.PlayerList{
+		Items:    page.Players,
+		Total:    int64(total),
+		PageSize: int32(params.PageSize),
+		Links:    links,
+	}
+	if query.PageNumber != nil {
+		list.PageNumber = query.PageNumber
+	}
+	if page.NextCursor != "" {
+		list.NextCursor = &page.NextCursor
+	}
+	return gen.ListPlayersV2200JSONResponse{
+		Body: list,
+		Headers: gen.ListPlayersV2200ResponseHeaders{
+			Link: linkHeader(links),
+		},
+	}, nil
+}
+
+// playersV2Query maps the /v2/players query onto the listing parameters both versions share.
+func playersV2Query(request gen.ListPlayersV2Params) gen.ListPlayersParams {
+	return gen.ListPlayersParams{
+		PageNumber:     request.PageNumber,
+		PageSize:       request.PageSize,
+		TeamId:         request.TeamId,
+		Role:           request.Role,
+		Citizenship:    request.Citizenship,
+		MinAge:         request.MinAge,
+		MaxAge:         request.MaxAge,
+		MinHeight:      request.MinHeight,
+		MaxHeight:      request.MaxHeight,
+		MinWeight:      request.MinWeight,
+		MaxWeight:      request.MaxWeight,
+		IncludeDeleted: request.IncludeDeleted,
+		Sort:           request.Sort,
+		Cursor:         request.Cursor,
+	}
+}
+
+// playerListParams converts listing query parameters into usecase.PlayerListParams.
+// It fails when the parameters contradict each other.
+func playerListParams(request gen.ListPlayersParams) (usecase.PlayerListParams, error) {
+	params := usecase.PlayerListParams{
+		PageSize:   defaultPageSize,
+		PageNumber: defaultPageNumber,
+		Filter: usecase.PlayerFilter{
+			TeamID:      request.TeamId,
+			Citizenship: request.Citizenship,
+			MinAge:      request.MinAge,
+			MaxAge:      request.MaxAge,
+			MinHeight:   request.MinHeight,
+			MaxHeight:   request.MaxHeight,
+			MinWeight:   request.MinWeight,
+			MaxWeight:   request.MaxWeight,
+		},
+	}
+
+	if request.PageNumber != nil {
+		params.PageNumber = uint64(*request.PageNumber)
+	}
+	if request.PageSize != nil {
+		params.PageSize = uint64(*request.PageSize)
+	}
+	if request.Role != nil {
+		role := string(*request.Role)
+		params.Filter.Role = &role
+	}
+	if request.IncludeDeleted != nil {
+		params.Filter.IncludeDeleted = *request.IncludeDeleted
+	}
+	if request.Sort != nil {
+		params.Sort = parsePlayerSort(*request.Sort)
+	}
+	if request.Cursor != nil {
+		if request.PageNumber != nil {
+			return params, apperrors.ErrPlayerCursorWithPageNumber
+		}
+		params.Cursor = *request.Cursor
+	}
+	return params, nil
+}
+
+// isInvalidPlayerList reports whether err was caused by invalid listing parameters.
+func isInvalidPlayerList(err error) bool {
+	return errors.Is(err, apperrors.ErrInvalidPlayerPageNumber) ||
+		errors.Is(err, apperrors.ErrInvalidPlayerPageSize) ||
+		errors.Is(err, apperrors.ErrInvalidPlayerSort) ||
+		errors.Is(err, apperrors.ErrInvalidPlayerCursor)
+}
+
+// parsePlayerSort converts sort keys like "-age" into usecase.PlayerSort values.
+func parsePlayerSort(keys []gen.PlayerSortKey) []usecase.PlayerSort {
+	sort := make([]usecase.PlayerSort, 0, len(keys))
+	for _, key := range keys {
+		field, desc := strings.CutPrefix(string(key), "-")
+		sort = append(sort, usecase.PlayerSort{
+			Field: usecase.PlayerSortField(field),
+			Desc:  desc,
+		})
+	}
+	return sort
+}
+
+// playerPageLinks returns the navigation links of page. Listings paged by page_number
+// navigate by page_number, all others by cursor. The last link needs the total and is
+// only available when paging by page_number.
+func playerPageLinks(path string, request gen.ListPlayersParams, params usecase.PlayerListParams, page *usecase.PlayerPage, total *uint64) gen.PageLinks {
+	link := func(name, value string) string {
+		query := playersQuery(request)
+		query.Set("page_size", strconv.FormatUint(params.PageSize, 10))
+		if name != "" {
+			query.Set(name, value)
+		}
+		return path + "?" + query.Encode()
+	}
+	pageLink := func(number uint64) string {
+		return link("page_number", strconv.FormatUint(number, 10))
+	}
+
+	if request.PageNumber == nil {
+		links := gen.PageLinks{
+			Self:  link("", ""),
+			First: link("", ""),
+		}
+		if params.Cursor != "" {
+			links.Self = link("cursor", params.Cursor)
+		}
+		if page.NextCursor != "" {
+			next := link("cursor", page.NextCursor)
+			links.Next = &next
+		}
+		return links
+	}
+
+	links := gen.PageLinks{
+		Self:  pageLink(params.PageNumber),
+		First: pageLink(1),
+	}
+	if params.PageNumber > 1 {
+		prev := pageLink(params.PageNumber - 1)
+		links.Prev = &prev
+	}
+	if page.NextCursor != "" {
+		next := pageLink(params.PageNumber + 1)
+		links.Next = &next
+	}
+	if total != nil {
+		last := pageLink(max(1, (*total+params.PageSize-1)/params.PageSize))
+		links.Last = &last
+	}
+	return links
+}
+
+// linkHeader formats the navigation links of links as a Link header (RFC 8288).
+func linkHeader(links gen.PageLinks) string {
+	var parts []string
+	add := func(rel string, target *string) {
+		if target != nil && *target != "" {
+			parts = append(parts, fmt.Sprintf(`<%s>; rel="%s"`, *target, rel))
+		}
+	}
+	add("next", links.Next)
+	add("prev", links.Prev)
+	add("first", &links.First)
+	add("last", links.Last)
+	return strings.Join(parts, ", ")
+}
+
+// playersQuery encodes the filter and sort parameters of a player listing.
+func playersQuery(request gen.ListPlayersParams) url.Values {
+	query := url.Values{}
+	if request.TeamId != nil {
+		query.Set("team_id", strconv.FormatInt(*request.TeamId, 10))
+	}
+	if request.Role != nil {
+		query.Set("role", string(*request.Role))
+	}
+	if request.Citizenship != nil {
+		query.Set("citizenship", *request.Citizenship)
+	}
+	setInt := func(name string, value *int) {
+		if value != nil {
+			query.Set(name, strconv.Itoa(*value))
+		}
+	}
+	setInt("min_age", request.MinAge)
+	setInt("max_age", request.MaxAge)
+	setInt("min_height", request.MinHeight)
+	setInt("max_height", request.MaxHeight)
+	setInt("min_weight", request.MinWeight)
+	setInt("max_weight", request.MaxWeight)
+	if request.IncludeDeleted != nil && *request.IncludeDeleted {
+		query.Set("include_deleted", "true")
+	}
+	if request.Sort != nil && len(*request.Sort) > 0 {
+		keys := make([]string, 0, len(*request.Sort))
+		for _, key := range *request.Sort {
+			keys = append(keys, string(key))
+		}
+		query.Set("sort", strings.Join(keys, ","))
+	}
+	return query
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/arsnazarenko/devops-basketball/api/gen"
+	"github.com/arsnazarenko/devops-basketball/internal/usecase"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -17,22 +18,58 @@ func (m *MockPlayer) CreatePlayer(ctx context.Context, player *gen.PlayerCreate)
 	return args.Get(0).(*gen.Player), args.Error(1)
 }
 
-func (m *MockPlayer) UpdatePlayer(ctx context.Context, playerID int64, player *gen.PlayerUpdate) (*gen.Player, error) {
-	args := m.Called(ctx, playerID, player)
+func (m *MockPlayer) UpdatePlayer(ctx context.Context, playerID, version int64, player *gen.PlayerUpdate) (*gen.Player, error) {
+	args := m.Called(ctx, playerID, version, player)
 	return args.Get(0).(*gen.Player), args.Error(1)
 }
 
-func (m *MockPlayer) DeletePlayer(ctx context.Context, playerID int64) error {
-	args := m.Called(ctx, playerID)
+func (m *MockPlayer) DeletePlayer(ctx context.Context, playerID, version int64) error {
+	args := m.Called(ctx, playerID, version)
 	return args.Error(0)
 }
 
+func (m *MockPlayer) RestorePlayer(ctx context.Context, playerID int64) (*gen.Player, error) {
+	args := m.Called(ctx, playerID)
+	return args.Get(0).(*gen.Player), args.Error(1)
+}
+
 func (m *MockPlayer) GetPlayer(ctx context.Context, playerID int64) (*gen.Player, error) {
 	args := m.Called(ctx, playerID)
 	return args.Get(0).(*gen.Player), args.Error(1)
 }
 
-func (m *MockPlayer) GetPlayerList(ctx context.Context, pageSize, pageNumber uint64) ([]gen.Player, error) {
-	args := m.Called(ctx, pageSize, pageNumber)
-	return args.Get(0).([]gen.Player), args.Error(1)
+func (m *MockPlayer) GetPlayerList(ctx context.Context, params usecase.PlayerListParams) (*usecase.PlayerPage, error) {
+	args := m.Called(ctx, params)
+	return args.Get(0).(*usecase.PlayerPage), args.Error(1)
+}
+
+func (m *MockPlayer) CountPlayers(ctx context.Context, filter usecase.PlayerFilter) (uint64, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).(uint64), args.Error(1)
+}
+
+func (m *MockPlayer) SearchPlayers(ctx context.Context, query string, limit uint64) ([]gen.PlayerSearchResult, error) {
+	args := m.Called(ctx, query, limit)
+	return args.Get(0).([]gen.PlayerSearchResult), args.Error(1)
+}
+
+func (m *MockPlayer) GetPlayerHistory(ctx context.Context, playerID int64, params usecase.PlayerHistoryParams) (*usecase.PlayerHistoryPage, error) {
+	args := m.Called(ctx, playerID, params)
+	return args.Get(0).(*usecase.PlayerHistoryPage), args.Error(1)
+}
+
+func (m *MockPlayer) BatchPlayers(ctx context.Context, ops []usecase.PlayerOperation, atomic bool) ([]usecase.PlayerOperationResult, error) {
+	args := m.Called(ctx, ops, atomic)
+	return args.Get(0).([]usecase.PlayerOperationResult), args.Error(1)
+}
+
+func (m *MockPlayer) ImportPlayers(ctx context.Context, rows []usecase.PlayerImportRow, dryRun bool) (*usecase.PlayerImportResult, error) {
+	args := m.Called(ctx, rows, dryRun)
+	return args.Get(0).(*usecase.PlayerImportResult), args.Error(1)
+}
+
+func (m *MockPlayer) ExportPlayers(ctx context.Context, params usecase.PlayerExportParams) (usecase.PlayerCursor, error) {
+	args := m.Called(ctx, params)
+	cursor, _ := args.Get(0).(usecase.PlayerCursor)
+	return cursor, args.Error(1)
 }
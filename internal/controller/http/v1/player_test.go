@@ -1,31 +1,42 @@
 package v1
 
 import (
+	"archive/zip"
 	"bytes"
+	"encoding/csv"
 	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/arsnazarenko/devops-basketball/api/gen"
 	"github.com/arsnazarenko/devops-basketball/internal/apperrors"
+	"github.com/arsnazarenko/devops-basketball/internal/usecase"
+	"github.com/getkin/kin-openapi/openapi3filter"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/cors"
-	"github.com/oapi-codegen/nethttp-middleware"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
 func setupTestServer(mockUC *MockPlayer) *httptest.Server {
-	// Create server implementation with mock
-	serversImpl := NewPlayersServerImpl(mockUC)
+	return newTestServer(mockUC, &MockTeam{}, &MockGame{})
+}
+
+func newTestServer(player usecase.Player, team usecase.Team, game usecase.Game) *httptest.Server {
+	// Create server implementation with mocks
+	serversImpl := NewServer(player, team, game)
 
 	// Create chi router
 	r := chi.NewRouter()
 	// Add CORS
 	r.Use(cors.Handler(cors.Options{
 		AllowedOrigins:   []string{"https://*", "http://*"},
-		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
+		AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
 		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
 		ExposedHeaders:   []string{"Link"},
 		AllowCredentials: false,
@@ -40,10 +51,11 @@ func setupTestServer(mockUC *MockPlayer) *httptest.Server {
 	swagger.Servers = nil
 
 	// Add validation middleware
-	r.Use(nethttpmiddleware.OapiRequestValidator(swagger))
+	r.Use(LimitImportSize)
+	r.Use(RequestValidator(swagger, openapi3filter.NoopAuthenticationFunc))
 
 	// Create strict handler
-	server := gen.NewStrictHandler(serversImpl, []gen.StrictMiddlewareFunc{})
+	server := NewStrictHandler(serversImpl)
 	gen.HandlerFromMux(server, r)
 
 	return httptest.NewServer(r)
@@ -116,6 +128,12 @@ func TestCreatePlayer(t *testing.T) {
 
 		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
 
+		var problem gen.Problem
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&problem))
+		require.Equal(t, "urn:problem:team-not-found", problem.Type)
+		// the body carries the status sent, not the 404 of a missing team resource
+		require.Equal(t, http.StatusBadRequest, problem.Status)
+
 		mockUC.AssertExpectations(t)
 	})
 
@@ -243,33 +261,35 @@ func TestListPlayers(t *testing.T) {
 	server := setupTestServer(mockUC)
 	defer server.Close()
 
+	expectedPlayers := []gen.Player{
+		{
+			Id:          1,
+			Name:        "John",
+			Surname:     "Doe",
+			Age:         25,
+			Height:      1900,
+			Weight:      85000,
+			Citizenship: "USA",
+			Role:        gen.PlayerRole("PG"),
+			TeamId:      1,
+		},
+		{
+			Id:          2,
+			Name:        "Jane",
+			Surname:     "Smith",
+			Age:         30,
+			Height:      1800,
+			Weight:      75000,
+			Citizenship: "CAN",
+			Role:        gen.PlayerRole("C"),
+			TeamId:      2,
+		},
+	}
+
 	t.Run("success", func(t *testing.T) {
-		expectedPlayers := []gen.Player{
-			{
-				Id:          1,
-				Name:        "John",
-				Surname:     "Doe",
-				Age:         25,
-				Height:      1900,
-				Weight:      85000,
-				Citizenship: "USA",
-				Role:        gen.PlayerRole("PG"),
-				TeamId:      1,
-			},
-			{
-				Id:          2,
-				Name:        "Jane",
-				Surname:     "Smith",
-				Age:         30,
-				Height:      1800,
-				Weight:      75000,
-				Citizenship: "CAN",
-				Role:        gen.PlayerRole("C"),
-				TeamId:      2,
-			},
-		}
 
-		mockUC.On("GetPlayerList", mock.Anything, uint64(20), uint64(1)).Return(expectedPlayers, nil).Once()
+		params := usecase.PlayerListParams{PageSize: 20, PageNumber: 1}
+		mockUC.On("GetPlayerList", mock.Anything, params).Return(&usecase.PlayerPage{Players: expectedPlayers}, nil).Once()
 
 		resp, err := http.Get(server.URL + "/players?page_size=20&page_number=1")
 		require.NoError(t, err)
@@ -285,6 +305,111 @@ func TestListPlayers(t *testing.T) {
 		mockUC.AssertExpectations(t)
 	})
 
+	t.Run("filters and sort", func(t *testing.T) {
+		params := usecase.PlayerListParams{
+			PageSize:   10,
+			PageNumber: 2,
+			Filter: usecase.PlayerFilter{
+				TeamID:         int64Ptr(3),
+				Role:           stringPtr("C"),
+				MinAge:         intPtr(20),
+				MaxHeight:      intPtr(2100),
+				IncludeDeleted: true,
+			},
+			Sort: []usecase.PlayerSort{
+				{Field: usecase.PlayerSortAge, Desc: true},
+				{Field: usecase.PlayerSortSurname},
+			},
+		}
+		mockUC.On("GetPlayerList", mock.Anything, params).Return(&usecase.PlayerPage{Players: []gen.Player{}}, nil).Once()
+
+		resp, err := http.Get(server.URL + "/players?page_size=10&page_number=2&team_id=3&role=C&min_age=20&max_height=2100&include_deleted=true&sort=-age,surname")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		mockUC.AssertExpectations(t)
+	})
+
+	t.Run("next page link by cursor", func(t *testing.T) {
+		params := usecase.PlayerListParams{
+			PageSize:   1,
+			PageNumber: defaultPageNumber,
+			Cursor:     "abc",
+			Filter:     usecase.PlayerFilter{TeamID: int64Ptr(1)},
+		}
+		page := &usecase.PlayerPage{
+			Players:    expectedPlayers[:1],
+			NextCursor: "def",
+		}
+		mockUC.On("GetPlayerList", mock.Anything, params).Return(page, nil).Once()
+
+		resp, err := http.Get(server.URL + "/players?page_size=1&team_id=1&cursor=abc")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, `</players?cursor=def&page_size=1&team_id=1>; rel="next"`, resp.Header.Get("Link"))
+
+		mockUC.AssertExpectations(t)
+	})
+
+	t.Run("next page link by page number", func(t *testing.T) {
+		params := usecase.PlayerListParams{PageSize: 1, PageNumber: 2}
+		page := &usecase.PlayerPage{
+			Players:    expectedPlayers[1:],
+			NextCursor: "def",
+		}
+		mockUC.On("GetPlayerList", mock.Anything, params).Return(page, nil).Once()
+
+		resp, err := http.Get(server.URL + "/players?page_size=1&page_number=2")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, `</players?page_number=3&page_size=1>; rel="next"`, resp.Header.Get("Link"))
+
+		mockUC.AssertExpectations(t)
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		params := usecase.PlayerListParams{PageSize: 20, PageNumber: defaultPageNumber, Cursor: "broken"}
+		mockUC.On("GetPlayerList", mock.Anything, params).Return((*usecase.PlayerPage)(nil), apperrors.ErrInvalidPlayerCursor).Once()
+
+		resp, err := http.Get(server.URL + "/players?cursor=broken")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		mockUC.AssertExpectations(t)
+	})
+
+	t.Run("cursor with page number", func(t *testing.T) {
+		resp, err := http.Get(server.URL + "/players?cursor=abc&page_number=2")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("unknown sort key", func(t *testing.T) {
+		resp, err := http.Get(server.URL + "/players?sort=-salary")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("invalid role filter", func(t *testing.T) {
+		resp, err := http.Get(server.URL + "/players?role=XX")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
 	t.Run("invalid page number", func(t *testing.T) {
 		// Since OpenAPI validation happens in middleware, no usecase call expected
 		resp, err := http.Get(server.URL + "/players?page_size=20&page_number=0")
@@ -347,11 +472,12 @@ func TestUpdatePlayer(t *testing.T) {
 			TeamId:      1,
 		}
 
-		mockUC.On("UpdatePlayer", mock.Anything, playerID, playerUpdate).Return(expectedPlayer, nil).Once()
+		mockUC.On("UpdatePlayer", mock.Anything, playerID, int64(3), playerUpdate).Return(expectedPlayer, nil).Once()
 
 		body, _ := json.Marshal(playerUpdate)
 		req, _ := http.NewRequest(http.MethodPut, server.URL+"/players/1", bytes.NewReader(body))
 		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("If-Match", `"3"`)
 		resp, err := http.DefaultClient.Do(req)
 		require.NoError(t, err)
 		defer resp.Body.Close()
@@ -372,11 +498,12 @@ func TestUpdatePlayer(t *testing.T) {
 			Name: stringPtr("Jane"),
 		}
 
-		mockUC.On("UpdatePlayer", mock.Anything, playerID, playerUpdate).Return((*gen.Player)(nil), apperrors.ErrPlayerNotFound).Once()
+		mockUC.On("UpdatePlayer", mock.Anything, playerID, int64(3), playerUpdate).Return((*gen.Player)(nil), apperrors.ErrPlayerNotFound).Once()
 
 		body, _ := json.Marshal(playerUpdate)
 		req, _ := http.NewRequest(http.MethodPut, server.URL+"/players/999", bytes.NewReader(body))
 		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("If-Match", `"3"`)
 		resp, err := http.DefaultClient.Do(req)
 		require.NoError(t, err)
 		defer resp.Body.Close()
@@ -392,11 +519,12 @@ func TestUpdatePlayer(t *testing.T) {
 			TeamId: int64Ptr(999),
 		}
 
-		mockUC.On("UpdatePlayer", mock.Anything, playerID, playerUpdate).Return((*gen.Player)(nil), apperrors.ErrTeamNotFound).Once()
+		mockUC.On("UpdatePlayer", mock.Anything, playerID, int64(3), playerUpdate).Return((*gen.Player)(nil), apperrors.ErrTeamNotFound).Once()
 
 		body, _ := json.Marshal(playerUpdate)
 		req, _ := http.NewRequest(http.MethodPut, server.URL+"/players/1", bytes.NewReader(body))
 		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("If-Match", `"3"`)
 		resp, err := http.DefaultClient.Do(req)
 		require.NoError(t, err)
 		defer resp.Body.Close()
@@ -407,6 +535,86 @@ func TestUpdatePlayer(t *testing.T) {
 	})
 }
 
+func TestPatchPlayer(t *testing.T) {
+	mockUC := &MockPlayer{}
+	server := setupTestServer(mockUC)
+	defer server.Close()
+
+	patch := func(t *testing.T, url, body string) *http.Response {
+		req, _ := http.NewRequest(http.MethodPatch, url, bytes.NewReader([]byte(body)))
+		req.Header.Set("Content-Type", "application/merge-patch+json")
+		req.Header.Set("If-Match", `"3"`)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		return resp
+	}
+
+	t.Run("success", func(t *testing.T) {
+		playerID := int64(1)
+		playerUpdate := &gen.PlayerUpdate{
+			Age:    intPtr(27),
+			TeamId: int64Ptr(2),
+		}
+
+		expectedPlayer := &gen.Player{
+			Id:          1,
+			Name:        "John",
+			Surname:     "Doe",
+			Age:         27,
+			Height:      1900,
+			Weight:      85000,
+			Citizenship: "USA",
+			Role:        gen.PlayerRole("PG"),
+			TeamId:      2,
+		}
+
+		mockUC.On("UpdatePlayer", mock.Anything, playerID, int64(3), playerUpdate).Return(expectedPlayer, nil).Once()
+
+		resp := patch(t, server.URL+"/players/1", `{"age":27,"teamId":2}`)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var response gen.Player
+		err := json.NewDecoder(resp.Body).Decode(&response)
+		require.NoError(t, err)
+		require.Equal(t, *expectedPlayer, response)
+
+		mockUC.AssertExpectations(t)
+	})
+
+	t.Run("player not found", func(t *testing.T) {
+		playerID := int64(999)
+		playerUpdate := &gen.PlayerUpdate{
+			Name: stringPtr("Jane"),
+		}
+
+		mockUC.On("UpdatePlayer", mock.Anything, playerID, int64(3), playerUpdate).Return((*gen.Player)(nil), apperrors.ErrPlayerNotFound).Once()
+
+		resp := patch(t, server.URL+"/players/999", `{"name":"Jane"}`)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+		mockUC.AssertExpectations(t)
+	})
+
+	t.Run("null value rejected", func(t *testing.T) {
+		// Player fields are not nullable, so they cannot be removed by a merge patch
+		resp := patch(t, server.URL+"/players/1", `{"name":null}`)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("invalid weight", func(t *testing.T) {
+		resp := patch(t, server.URL+"/players/1", `{"weight":100}`)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
 // Helper functions for pointers
 func stringPtr(s string) *string {
 	return &s
@@ -432,9 +640,10 @@ func TestDeletePlayer(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		playerID := int64(1)
 
-		mockUC.On("DeletePlayer", mock.Anything, playerID).Return(nil).Once()
+		mockUC.On("DeletePlayer", mock.Anything, playerID, int64(3)).Return(nil).Once()
 
 		req, _ := http.NewRequest(http.MethodDelete, server.URL+"/players/1", nil)
+		req.Header.Set("If-Match", `"3"`)
 		resp, err := http.DefaultClient.Do(req)
 		require.NoError(t, err)
 		defer resp.Body.Close()
@@ -447,9 +656,10 @@ func TestDeletePlayer(t *testing.T) {
 	t.Run("player not found", func(t *testing.T) {
 		playerID := int64(999)
 
-		mockUC.On("DeletePlayer", mock.Anything, playerID).Return(apperrors.ErrPlayerNotFound).Once()
+		mockUC.On("DeletePlayer", mock.Anything, playerID, int64(3)).Return(apperrors.ErrPlayerNotFound).Once()
 
 		req, _ := http.NewRequest(http.MethodDelete, server.URL+"/players/999", nil)
+		req.Header.Set("If-Match", `"3"`)
 		resp, err := http.DefaultClient.Do(req)
 		require.NoError(t, err)
 		defer resp.Body.Close()
@@ -468,3 +678,697 @@ func TestDeletePlayer(t *testing.T) {
 		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
 	})
 }
+
+func TestListPlayersV2(t *testing.T) {
+	mockUC := &MockPlayer{}
+	server := setupTestServer(mockUC)
+	defer server.Close()
+
+	players := []gen.Player{
+		{
+			Id:          3,
+			Name:        "John",
+			Surname:     "Doe",
+			Age:         25,
+			Height:      1900,
+			Weight:      85000,
+			Citizenship: "USA",
+			Role:        gen.PlayerRole("PG"),
+			TeamId:      1,
+		},
+	}
+
+	t.Run("page number", func(t *testing.T) {
+		params := usecase.PlayerListParams{
+			PageSize:   1,
+			PageNumber: 3,
+			Filter:     usecase.PlayerFilter{TeamID: int64Ptr(1)},
+		}
+		mockUC.On("GetPlayerList", mock.Anything, params).Return(&usecase.PlayerPage{Players: players, NextCursor: "abc"}, nil).Once()
+		mockUC.On("CountPlayers", mock.Anything, params.Filter).Return(uint64(5), nil).Once()
+
+		resp, err := http.Get(server.URL + "/v2/players?page_size=1&page_number=3&team_id=1")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var response gen.PlayerList
+		err = json.NewDecoder(resp.Body).Decode(&response)
+		require.NoError(t, err)
+		require.Equal(t, players, response.Items)
+		require.Equal(t, int64(5), response.Total)
+		require.Equal(t, int32(3), *response.PageNumber)
+		require.Equal(t, int32(1), response.PageSize)
+		require.Equal(t, "/v2/players?page_number=3&page_size=1&team_id=1", response.Links.Self)
+		require.Equal(t, "/v2/players?page_number=1&page_size=1&team_id=1", response.Links.First)
+		require.Equal(t, "/v2/players?page_number=2&page_size=1&team_id=1", *response.Links.Prev)
+		require.Equal(t, "/v2/players?page_number=4&page_size=1&team_id=1", *response.Links.Next)
+		require.Equal(t, "/v2/players?page_number=5&page_size=1&team_id=1", *response.Links.Last)
+		require.Contains(t, resp.Header.Get("Link"), `</v2/players?page_number=4&page_size=1&team_id=1>; rel="next"`)
+
+		mockUC.AssertExpectations(t)
+	})
+
+	t.Run("cursor", func(t *testing.T) {
+		params := usecase.PlayerListParams{PageSize: 20, PageNumber: defaultPageNumber}
+		mockUC.On("GetPlayerList", mock.Anything, params).Return(&usecase.PlayerPage{Players: players, NextCursor: "abc"}, nil).Once()
+		mockUC.On("CountPlayers", mock.Anything, params.Filter).Return(uint64(42), nil).Once()
+
+		resp, err := http.Get(server.URL + "/v2/players")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var response gen.PlayerList
+		err = json.NewDecoder(resp.Body).Decode(&response)
+		require.NoError(t, err)
+		require.Equal(t, int64(42), response.Total)
+		require.Nil(t, response.PageNumber)
+		require.Equal(t, "abc", *response.NextCursor)
+		require.Equal(t, "/v2/players?cursor=abc&page_size=20", *response.Links.Next)
+		require.Nil(t, response.Links.Last)
+
+		mockUC.AssertExpectations(t)
+	})
+}
+
+func TestSearchPlayers(t *testing.T) {
+	mockUC := &MockPlayer{}
+	server := setupTestServer(mockUC)
+	defer server.Close()
+
+	t.Run("success", func(t *testing.T) {
+		expected := []gen.PlayerSearchResult{
+			{
+				Player: gen.Player{
+					Id:          77,
+					Name:        "Luka",
+					Surname:     "Dončić",
+					Age:         25,
+					Height:      2010,
+					Weight:      104000,
+					Citizenship: "SVN",
+					Role:        gen.PlayerRole("PG"),
+					TeamId:      1,
+				},
+				Rank: 0.5,
+			},
+		}
+		mockUC.On("SearchPlayers", mock.Anything, "Doncic", uint64(5)).Return(expected, nil).Once()
+
+		resp, err := http.Get(server.URL + "/players/search?q=Doncic&limit=5")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var response []gen.PlayerSearchResult
+		err = json.NewDecoder(resp.Body).Decode(&response)
+		require.NoError(t, err)
+		require.Equal(t, expected, response)
+
+		mockUC.AssertExpectations(t)
+	})
+
+	t.Run("missing query", func(t *testing.T) {
+		resp, err := http.Get(server.URL + "/players/search")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("query too short", func(t *testing.T) {
+		resp, err := http.Get(server.URL + "/players/search?q=a")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func TestGetPlayerHistory(t *testing.T) {
+	mockUC := &MockPlayer{}
+	server := setupTestServer(mockUC)
+	defer server.Close()
+
+	t.Run("success", func(t *testing.T) {
+		before := gen.Player{Id: 1, Name: "John", Surname: "Doe", Age: 25, Height: 1900, Weight: 85000, Citizenship: "USA", Role: "PG", TeamId: 1}
+		after := before
+		after.Age = 26
+		page := &usecase.PlayerHistoryPage{
+			Entries: []gen.PlayerAuditEntry{
+				{Id: 7, PlayerId: 1, Actor: "jwt:alice", Operation: gen.PlayerAuditOperationUpdate, ChangedAt: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC), Before: &before, After: &after},
+			},
+			NextCursor: "Nw",
+		}
+		mockUC.On("GetPlayerHistory", mock.Anything, int64(1), usecase.PlayerHistoryParams{PageSize: 1, Cursor: "OA"}).Return(page, nil).Once()
+
+		resp, err := http.Get(server.URL + "/players/1/history?page_size=1&cursor=OA")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var history gen.PlayerHistory
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&history))
+		require.Equal(t, page.Entries, history.Items)
+		require.NotNil(t, history.NextCursor)
+		require.Equal(t, "Nw", *history.NextCursor)
+
+		mockUC.AssertExpectations(t)
+	})
+
+	t.Run("player not found", func(t *testing.T) {
+		mockUC.On("GetPlayerHistory", mock.Anything, int64(999), usecase.PlayerHistoryParams{PageSize: 20}).
+			Return((*usecase.PlayerHistoryPage)(nil), apperrors.ErrPlayerNotFound).Once()
+
+		resp, err := http.Get(server.URL + "/players/999/history")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+		mockUC.AssertExpectations(t)
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		mockUC.On("GetPlayerHistory", mock.Anything, int64(1), usecase.PlayerHistoryParams{PageSize: 20, Cursor: "bad"}).
+			Return((*usecase.PlayerHistoryPage)(nil), apperrors.ErrInvalidHistoryCursor).Once()
+
+		resp, err := http.Get(server.URL + "/players/1/history?cursor=bad")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		mockUC.AssertExpectations(t)
+	})
+}
+
+func TestRestorePlayer(t *testing.T) {
+	mockUC := &MockPlayer{}
+	server := setupTestServer(mockUC)
+	defer server.Close()
+
+	t.Run("success", func(t *testing.T) {
+		restored := &gen.Player{Id: 1, Name: "John", Surname: "Doe", Age: 25, Height: 1900, Weight: 85000, Citizenship: "USA", Role: "PG", TeamId: 1}
+		mockUC.On("RestorePlayer", mock.Anything, int64(1)).Return(restored, nil).Once()
+
+		resp, err := http.Post(server.URL+"/players/1:restore", "application/json", nil)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var response gen.Player
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&response))
+		require.Equal(t, *restored, response)
+
+		mockUC.AssertExpectations(t)
+	})
+
+	t.Run("not deleted", func(t *testing.T) {
+		mockUC.On("RestorePlayer", mock.Anything, int64(2)).Return((*gen.Player)(nil), apperrors.ErrPlayerNotDeleted).Once()
+
+		resp, err := http.Post(server.URL+"/players/2:restore", "application/json", nil)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusConflict, resp.StatusCode)
+
+		mockUC.AssertExpectations(t)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockUC.On("RestorePlayer", mock.Anything, int64(999)).Return((*gen.Player)(nil), apperrors.ErrPlayerNotFound).Once()
+
+		resp, err := http.Post(server.URL+"/players/999:restore", "application/json", nil)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+		mockUC.AssertExpectations(t)
+	})
+}
+
+func TestPlayerConditionalRequests(t *testing.T) {
+	mockUC := &MockPlayer{}
+	server := setupTestServer(mockUC)
+	defer server.Close()
+
+	player := &gen.Player{Id: 1, Name: "John", Surname: "Doe", Age: 25, Height: 1900, Weight: 85000, Citizenship: "USA", Role: "PG", TeamId: 1, Version: 4}
+	do := func(t *testing.T, method, url string, header map[string]string) *http.Response {
+		var body io.Reader
+		if method == http.MethodPut {
+			body = strings.NewReader(`{"age":26}`)
+		}
+		req, _ := http.NewRequest(method, url, body)
+		req.Header.Set("Content-Type", "application/json")
+		for k, v := range header {
+			req.Header.Set(k, v)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		return resp
+	}
+
+	t.Run("get returns etag", func(t *testing.T) {
+		mockUC.On("GetPlayer", mock.Anything, int64(1)).Return(player, nil).Once()
+
+		resp := do(t, http.MethodGet, server.URL+"/players/1", nil)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, `"4"`, resp.Header.Get("ETag"))
+	})
+
+	t.Run("if-none-match returns 304", func(t *testing.T) {
+		mockUC.On("GetPlayer", mock.Anything, int64(1)).Return(player, nil).Once()
+
+		resp := do(t, http.MethodGet, server.URL+"/players/1", map[string]string{"If-None-Match": `"3", W/"4"`})
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusNotModified, resp.StatusCode)
+		require.Equal(t, `"4"`, resp.Header.Get("ETag"))
+	})
+
+	t.Run("stale if-none-match returns player", func(t *testing.T) {
+		mockUC.On("GetPlayer", mock.Anything, int64(1)).Return(player, nil).Once()
+
+		resp := do(t, http.MethodGet, server.URL+"/players/1", map[string]string{"If-None-Match": `"3"`})
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("missing if-match", func(t *testing.T) {
+		for _, method := range []string{http.MethodPut, http.MethodDelete} {
+			resp := do(t, method, server.URL+"/players/1", nil)
+			resp.Body.Close()
+			require.Equal(t, http.StatusPreconditionRequired, resp.StatusCode, method)
+		}
+	})
+
+	t.Run("stale if-match", func(t *testing.T) {
+		mockUC.On("UpdatePlayer", mock.Anything, int64(1), int64(3), &gen.PlayerUpdate{Age: intPtr(26)}).
+			Return((*gen.Player)(nil), apperrors.ErrVersionMismatch).Once()
+
+		resp := do(t, http.MethodPut, server.URL+"/players/1", map[string]string{"If-Match": `"3"`})
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusPreconditionFailed, resp.StatusCode)
+	})
+
+	t.Run("weak if-match never matches", func(t *testing.T) {
+		resp := do(t, http.MethodDelete, server.URL+"/players/1", map[string]string{"If-Match": `W/"4"`})
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusPreconditionFailed, resp.StatusCode)
+	})
+
+	t.Run("update returns new etag", func(t *testing.T) {
+		updated := *player
+		updated.Version = 5
+		mockUC.On("UpdatePlayer", mock.Anything, int64(1), int64(4), &gen.PlayerUpdate{Age: intPtr(26)}).Return(&updated, nil).Once()
+
+		resp := do(t, http.MethodPut, server.URL+"/players/1", map[string]string{"If-Match": `"4"`})
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, `"5"`, resp.Header.Get("ETag"))
+	})
+
+	mockUC.AssertExpectations(t)
+}
+
+func TestBatchPlayers(t *testing.T) {
+	mockUC := &MockPlayer{}
+	server := setupTestServer(mockUC)
+	defer server.Close()
+
+	post := func(t *testing.T, body string) *http.Response {
+		resp, err := http.Post(server.URL+"/players:batch", "application/json", strings.NewReader(body))
+		require.NoError(t, err)
+		return resp
+	}
+	created := &gen.Player{Id: 5, Name: "John", Surname: "Doe", Age: 25, Height: 1900, Weight: 85000, Citizenship: "USA", Role: "PG", TeamId: 1, Version: 1}
+	body := `{"mode": "best_effort", "operations": [
+		{"op": "create", "player": {"name": "John", "surname": "Doe", "age": 25, "height": 1900, "weight": 85000, "citizenship": "USA", "role": "PG", "teamId": 1}},
+		{"op": "delete", "id": 2, "version": 3},
+		{"op": "update", "id": 3, "version": 1, "changes": {"teamId": 9}}
+	]}`
+
+	t.Run("best effort", func(t *testing.T) {
+		mockUC.On("BatchPlayers", mock.Anything, mock.MatchedBy(func(ops []usecase.PlayerOperation) bool {
+			return len(ops) == 3 && ops[1].ID == 2 && ops[1].Version == 3 && ops[2].Update != nil
+		}), false).Return([]usecase.PlayerOperationResult{
+			{Player: created},
+			{Err: apperrors.ErrVersionMismatch},
+			{Err: apperrors.ErrTeamNotFound},
+		}, nil).Once()
+
+		resp := post(t, body)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var response gen.PlayerBatchResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&response))
+		require.Equal(t, gen.BestEffort, response.Mode)
+		require.Equal(t, 1, response.Succeeded)
+		require.Equal(t, 2, response.Failed)
+		require.Len(t, response.Results, 3)
+		require.Equal(t, http.StatusCreated, response.Results[0].Status)
+		require.Equal(t, *created, *response.Results[0].Player)
+		require.Equal(t, http.StatusPreconditionFailed, response.Results[1].Status)
+		require.Equal(t, "urn:problem:version-mismatch", response.Results[1].Problem.Type)
+		require.Equal(t, http.StatusBadRequest, response.Results[2].Status)
+		require.Equal(t, http.StatusBadRequest, response.Results[2].Problem.Status)
+		require.Equal(t, 2, response.Results[2].Index)
+
+		mockUC.AssertExpectations(t)
+	})
+
+	t.Run("atomic by default", func(t *testing.T) {
+		mockUC.On("BatchPlayers", mock.Anything, mock.Anything, true).Return([]usecase.PlayerOperationResult{
+			{Err: apperrors.ErrPlayerNotFound},
+			{Err: apperrors.ErrBatchAborted},
+		}, nil).Once()
+
+		resp := post(t, `{"operations": [{"op": "delete", "id": 1, "version": 1}, {"op": "delete", "id": 2, "version": 1}]}`)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var response gen.PlayerBatchResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&response))
+		require.Equal(t, gen.Atomic, response.Mode)
+		require.Equal(t, 2, response.Failed)
+		require.Equal(t, http.StatusNotFound, response.Results[0].Status)
+		require.Equal(t, http.StatusFailedDependency, response.Results[1].Status)
+
+		mockUC.AssertExpectations(t)
+	})
+
+	t.Run("missing fields", func(t *testing.T) {
+		resp := post(t, `{"operations": [{"op": "update", "id": 1, "changes": {}}, {"op": "create"}]}`)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		var problem gen.Problem
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&problem))
+		require.Equal(t, "urn:problem:invalid-batch-operation", problem.Type)
+		require.NotNil(t, problem.Errors)
+		require.Equal(t, []gen.FieldError{
+			{Field: "operations/0/version", Message: "required for op update"},
+			{Field: "operations/1/player", Message: "required for op create"},
+		}, *problem.Errors)
+	})
+
+	t.Run("too many operations", func(t *testing.T) {
+		ops := strings.Repeat(`{"op": "delete", "id": 1, "version": 1},`, 501)
+		resp := post(t, `{"operations": [`+strings.TrimSuffix(ops, ",")+`]}`)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func TestImportPlayers(t *testing.T) {
+	mockUC := &MockPlayer{}
+	server := setupTestServer(mockUC)
+	defer server.Close()
+
+	upload := func(t *testing.T, query, contentType, body string) *http.Response {
+		resp, err := http.Post(server.URL+"/players/import"+query, contentType, strings.NewReader(body))
+		require.NoError(t, err)
+		return resp
+	}
+	curry := gen.PlayerCreate{Name: "Stephen", Surname: "Curry", Age: 36, Height: 1910, Weight: 86000, Citizenship: "USA", Role: "PG", TeamId: 1}
+
+	t.Run("csv", func(t *testing.T) {
+		mockUC.On("ImportPlayers", mock.Anything, []usecase.PlayerImportRow{{Line: 2, Player: curry}}, false).
+			Return(&usecase.PlayerImportResult{Imported: 1}, nil).Once()
+
+		body := "\ufeffname,surname,age,height,weight,citizenship,role,teamId\n" +
+			"Stephen,Curry,36,1910,86000,USA,PG,1\n" +
+			"Luka,Doncic,12,2010,104000,SVN,XX,2\n" +
+			"Nikola,Jokic,29\n"
+		resp := upload(t, "", "text/csv; charset=utf-8", body)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var report gen.PlayerImportReport
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&report))
+		require.False(t, report.DryRun)
+		require.Equal(t, 3, report.Rows)
+		require.Equal(t, 1, report.Imported)
+		require.Len(t, report.Rejected, 2)
+		require.Equal(t, 3, report.Rejected[0].Line)
+		fields := []string{}
+		for _, e := range report.Rejected[0].Errors {
+			fields = append(fields, e.Field)
+		}
+		require.ElementsMatch(t, []string{"age", "role"}, fields)
+		require.Equal(t, 4, report.Rejected[1].Line)
+
+		mockUC.AssertExpectations(t)
+	})
+
+	t.Run("ndjson dry run", func(t *testing.T) {
+		mockUC.On("ImportPlayers", mock.Anything, []usecase.PlayerImportRow{{Line: 1, Player: curry}}, true).
+			Return(&usecase.PlayerImportResult{Imported: 1}, nil).Once()
+
+		body := `{"name": "Stephen", "surname": "Curry", "age": 36, "height": 1910, "weight": 86000, "citizenship": "USA", "role": "PG", "teamId": 1}` + "\n\n" +
+			`{"name": "Luka"` + "\n"
+		resp := upload(t, "?dry_run=true", "application/x-ndjson", body)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var report gen.PlayerImportReport
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&report))
+		require.True(t, report.DryRun)
+		require.Equal(t, 2, report.Rows)
+		require.Len(t, report.Rejected, 1)
+		require.Equal(t, 3, report.Rejected[0].Line)
+
+		mockUC.AssertExpectations(t)
+	})
+
+	t.Run("ndjson line too long", func(t *testing.T) {
+		mockUC.On("ImportPlayers", mock.Anything, []usecase.PlayerImportRow{{Line: 1, Player: curry}, {Line: 3, Player: curry}}, false).
+			Return(&usecase.PlayerImportResult{Imported: 2}, nil).Once()
+
+		line := `{"name": "Stephen", "surname": "Curry", "age": 36, "height": 1910, "weight": 86000, "citizenship": "USA", "role": "PG", "teamId": 1}`
+		body := line + "\n" + `{"name": "` + strings.Repeat("a", maxNDJSONLine) + `"}` + "\n" + line
+		resp := upload(t, "", "application/x-ndjson", body)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var report gen.PlayerImportReport
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&report))
+		require.Equal(t, 3, report.Rows)
+		require.Len(t, report.Rejected, 1)
+		require.Equal(t, 2, report.Rejected[0].Line)
+
+		mockUC.AssertExpectations(t)
+	})
+
+	t.Run("invalid header", func(t *testing.T) {
+		resp := upload(t, "", "text/csv", "name,surname\nStephen,Curry\n")
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		var problem gen.Problem
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&problem))
+		require.Equal(t, "urn:problem:invalid-import-header", problem.Type)
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		resp := upload(t, "", "text/plain", "Stephen Curry")
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusUnsupportedMediaType, resp.StatusCode)
+	})
+
+	t.Run("too large", func(t *testing.T) {
+		// a reader of unknown length, so the upload is sent chunked
+		body := io.MultiReader(strings.NewReader(strings.Repeat("\n", maxImportSize+1)))
+		resp, err := http.Post(server.URL+"/players/import", "application/x-ndjson", body)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusRequestEntityTooLarge, resp.StatusCode)
+
+		var problem gen.Problem
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&problem))
+		require.Equal(t, "urn:problem:import-too-large", problem.Type)
+		require.Equal(t, http.StatusRequestEntityTooLarge, problem.Status)
+	})
+}
+
+// sliceCursor is a usecase.PlayerCursor over players that fails with err once they are read.
+type sliceCursor struct {
+	players []gen.Player
+	err     error
+	closed  bool
+}
+
+func (c *sliceCursor) Next(player *gen.Player) bool {
+	if len(c.players) == 0 {
+		return false
+	}
+	*player, c.players = c.players[0], c.players[1:]
+	return true
+}
+
+func (c *sliceCursor) Err() error {
+	return c.err
+}
+
+func (c *sliceCursor) Close() {
+	c.closed = true
+}
+
+func TestExportPlayers(t *testing.T) {
+	mockUC := &MockPlayer{}
+	server := setupTestServer(mockUC)
+	defer server.Close()
+
+	export := func(t *testing.T, query, accept string) *http.Response {
+		req, err := http.NewRequest(http.MethodGet, server.URL+"/players/export"+query, nil)
+		require.NoError(t, err)
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		return resp
+	}
+	players := func() []gen.Player {
+		return []gen.Player{
+			{Id: 1, Name: "Stephen", Surname: "Curry", Age: 36, Height: 1910, Weight: 86000, Citizenship: "USA", Role: "PG", TeamId: 1, Version: 2},
+			{Id: 2, Name: "Luka", Surname: "Doncic, Jr.", Age: 25, Height: 2010, Weight: 104000, Citizenship: "SVN", Role: "PG", TeamId: 2, Version: 1},
+		}
+	}
+
+	t.Run("csv by default", func(t *testing.T) {
+		cursor := &sliceCursor{players: players()}
+		teamID := int64(1)
+		mockUC.On("ExportPlayers", mock.Anything, usecase.PlayerExportParams{
+			Filter: usecase.PlayerFilter{TeamID: &teamID},
+			Sort:   []usecase.PlayerSort{{Field: usecase.PlayerSortAge, Desc: true}},
+		}).Return(cursor, nil).Once()
+
+		resp := export(t, "?team_id=1&sort=-age", "")
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, "text/csv", resp.Header.Get("Content-Type"))
+		require.Equal(t, `attachment; filename=players.csv`, resp.Header.Get("Content-Disposition"))
+
+		records, err := csv.NewReader(resp.Body).ReadAll()
+		require.NoError(t, err)
+		require.Equal(t, [][]string{
+			{"id", "name", "surname", "age", "height", "weight", "citizenship", "role", "teamId", "version", "deletedAt"},
+			{"1", "Stephen", "Curry", "36", "1910", "86000", "USA", "PG", "1", "2", ""},
+			{"2", "Luka", "Doncic, Jr.", "25", "2010", "104000", "SVN", "PG", "2", "1", ""},
+		}, records)
+		require.True(t, cursor.closed)
+
+		mockUC.AssertExpectations(t)
+	})
+
+	t.Run("csv escapes formulas", func(t *testing.T) {
+		player := gen.Player{Id: 3, Name: "=HYPERLINK(\"http://evil\")", Surname: "@Sum", Age: 30, Height: 2000, Weight: 90000, Citizenship: "-1", Role: "C", TeamId: 4, Version: 1}
+		teamID := int64(4)
+		mockUC.On("ExportPlayers", mock.Anything, usecase.PlayerExportParams{Filter: usecase.PlayerFilter{TeamID: &teamID}}).
+			Return(&sliceCursor{players: []gen.Player{player}}, nil).Once()
+
+		resp := export(t, "?team_id=4", "")
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		records, err := csv.NewReader(resp.Body).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		require.Equal(t, []string{"3", `'=HYPERLINK("http://evil")`, "'@Sum", "30", "2000", "90000", "'-1", "C", "4", "1", ""}, records[1])
+
+		mockUC.AssertExpectations(t)
+	})
+
+	t.Run("ndjson by accept", func(t *testing.T) {
+		mockUC.On("ExportPlayers", mock.Anything, usecase.PlayerExportParams{}).
+			Return(&sliceCursor{players: players()[:1]}, nil).Once()
+
+		resp := export(t, "?units=human", "text/csv;q=0.5, application/x-ndjson")
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, "application/x-ndjson", resp.Header.Get("Content-Type"))
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.Equal(t, `{"id":1,"name":"Stephen","surname":"Curry","age":36,"heightM":1.91,"weightKg":86,`+
+			`"citizenship":"USA","role":"PG","teamId":1,"version":2}`+"\n", string(body))
+
+		mockUC.AssertExpectations(t)
+	})
+
+	t.Run("xlsx by format", func(t *testing.T) {
+		mockUC.On("ExportPlayers", mock.Anything, usecase.PlayerExportParams{}).
+			Return(&sliceCursor{players: players()}, nil).Once()
+
+		resp := export(t, "?format=xlsx", "text/csv")
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, "attachment; filename=players.xlsx", resp.Header.Get("Content-Disposition"))
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		workbook, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+		require.NoError(t, err)
+		sheet, err := workbook.Open("xl/worksheets/sheet1.xml")
+		require.NoError(t, err)
+		data, err := io.ReadAll(sheet)
+		require.NoError(t, err)
+		require.Contains(t, string(data), `<row r="3">`)
+		require.Contains(t, string(data), "Doncic, Jr.")
+
+		mockUC.AssertExpectations(t)
+	})
+
+	t.Run("not acceptable", func(t *testing.T) {
+		resp := export(t, "", "application/json, text/*;q=0")
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusNotAcceptable, resp.StatusCode)
+
+		var problem gen.Problem
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&problem))
+		require.Equal(t, "urn:problem:not-acceptable", problem.Type)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		mockUC.On("ExportPlayers", mock.Anything, usecase.PlayerExportParams{Filter: usecase.PlayerFilter{IncludeDeleted: true}}).
+			Return(nil, apperrors.ErrForbidden).Once()
+
+		resp := export(t, "?include_deleted=true", "")
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+		mockUC.AssertExpectations(t)
+	})
+
+	t.Run("too many exports", func(t *testing.T) {
+		teamID := int64(3)
+		mockUC.On("ExportPlayers", mock.Anything, usecase.PlayerExportParams{Filter: usecase.PlayerFilter{TeamID: &teamID}}).
+			Return(nil, apperrors.ErrTooManyExports).Once()
+
+		resp := export(t, "?team_id=3", "")
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		require.Equal(t, "urn:problem:too-many-exports", decodeProblem(t, resp).Type)
+
+		mockUC.AssertExpectations(t)
+	})
+
+	t.Run("aborted", func(t *testing.T) {
+		mockUC.On("ExportPlayers", mock.Anything, usecase.PlayerExportParams{}).
+			Return(&sliceCursor{players: players(), err: errors.New("connection reset")}, nil).Once()
+
+		// the connection is dropped, before or after the status was received
+		resp, err := http.Get(server.URL + "/players/export?format=ndjson")
+		if err == nil {
+			defer resp.Body.Close()
+			_, err = io.ReadAll(resp.Body)
+		}
+		require.Error(t, err)
+
+		mockUC.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,209 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/arsnazarenko/devops-basketball/api/gen"
+	"github.com/arsnazarenko/devops-basketball/internal/apperrors"
+	"github.com/arsnazarenko/devops-basketball/internal/controller/http/middleware"
+	"github.com/arsnazarenko/devops-basketball/pkg/logger"
+)
+
+const (
+	problemContentType = "application/problem+json"
+	problemTypePrefix  = "urn:problem:"
+)
+
+// problemKind describes how an application error is reported to clients.
+type problemKind struct {
+	status int
+	slug   string
+	title  string
+}
+
+// problemKinds maps every apperrors sentinel to its problem. The sentinel
+// message becomes the problem detail.
+var problemKinds = []struct {
+	err  error
+	kind problemKind
+}{
+	{apperrors.ErrPlayerNotFound, problemKind{http.StatusNotFound, "player-not-found", "Player not found"}},
+	{apperrors.ErrPlayerNotDeleted, problemKind{http.StatusConflict, "player-not-deleted", "Player is not deleted"}},
+	{apperrors.ErrVersionMismatch, problemKind{http.StatusPreconditionFailed, "version-mismatch", "Version mismatch"}},
+	{apperrors.ErrPreconditionRequired, problemKind{http.StatusPreconditionRequired, "precondition-required", "Precondition required"}},
+	{apperrors.ErrTeamNotFound, problemKind{http.StatusNotFound, "team-not-found", "Team not found"}},
+	{apperrors.ErrForbidden, problemKind{http.StatusForbidden, "forbidden", "Forbidden"}},
+	{apperrors.ErrTeamHasPlayers, problemKind{http.StatusConflict, "team-has-players", "Team still has players"}},
+	{apperrors.ErrInvalidField, problemKind{http.StatusBadRequest, "invalid-field", "Invalid field value"}},
+	{apperrors.ErrConflictingField, problemKind{http.StatusConflict, "conflicting-field", "Field value conflicts with existing data"}},
+	{apperrors.ErrConstraintViolation, problemKind{http.StatusConflict, "constraint-violation", "Constraint violation"}},
+	{apperrors.ErrInvalidPlayerPageSize, problemKind{http.StatusBadRequest, "invalid-page-size", "Invalid page size"}},
+	{apperrors.ErrInvalidPlayerPageNumber, problemKind{http.StatusBadRequest, "invalid-page-number", "Invalid page number"}},
+	{apperrors.ErrInvalidPlayerSort, problemKind{http.StatusBadRequest, "invalid-sort", "Invalid sort"}},
+	{apperrors.ErrInvalidPlayerCursor, problemKind{http.StatusBadRequest, "invalid-cursor", "Invalid cursor"}},
+	{apperrors.ErrPlayerCursorWithPageNumber, problemKind{http.StatusBadRequest, "invalid-cursor", "Invalid cursor"}},
+	{apperrors.ErrInvalidHistoryCursor, problemKind{http.StatusBadRequest, "invalid-cursor", "Invalid cursor"}},
+	{apperrors.ErrInvalidIdempotencyKey, problemKind{http.StatusBadRequest, "invalid-idempotency-key", "Invalid idempotency key"}},
+	{apperrors.ErrIdempotencyKeyReused, problemKind{http.StatusUnprocessableEntity, "idempotency-key-reused", "Idempotency key reused"}},
+	{apperrors.ErrIdempotencyKeyInProgress, problemKind{http.StatusConflict, "idempotency-key-in-progress", "Idempotency key in progress"}},
+	{apperrors.ErrInvalidBatchOperation, problemKind{http.StatusBadRequest, "invalid-batch-operation", "Invalid batch operation"}},
+	{apperrors.ErrBatchAborted, problemKind{http.StatusFailedDependency, "batch-aborted", "Batch aborted"}},
+	{apperrors.ErrUnsupportedImportFormat, problemKind{http.StatusUnsupportedMediaType, "unsupported-import-format", "Unsupported import format"}},
+	{apperrors.ErrInvalidImportHeader, problemKind{http.StatusBadRequest, "invalid-import-header", "Invalid import header"}},
+	{apperrors.ErrImportTooLarge, problemKind{http.StatusRequestEntityTooLarge, "import-too-large", "Import too large"}},
+	{apperrors.ErrNotAcceptableExport, problemKind{http.StatusNotAcceptable, "not-acceptable", "Not acceptable"}},
+	{apperrors.ErrTooManyExports, problemKind{http.StatusServiceUnavailable, "too-many-exports", "Too many exports"}},
+	{apperrors.ErrInvalidTeamPageSize, problemKind{http.StatusBadRequest, "invalid-page-size", "Invalid page size"}},
+	{apperrors.ErrInvalidTeamPageNumber, problemKind{http.StatusBadRequest, "invalid-page-number", "Invalid page number"}},
+	{apperrors.ErrTeamHasGames, problemKind{http.StatusConflict, "team-has-games", "Team still plays in games"}},
+	{apperrors.ErrGameNotFound, problemKind{http.StatusNotFound, "game-not-found", "Game not found"}},
+	{apperrors.ErrInvalidGamePageSize, problemKind{http.StatusBadRequest, "invalid-page-size", "Invalid page size"}},
+	{apperrors.ErrInvalidGamePageNumber, problemKind{http.StatusBadRequest, "invalid-page-number", "Invalid page number"}},
+	{apperrors.ErrInvalidGameRange, problemKind{http.StatusBadRequest, "invalid-game-range", "Invalid game range"}},
+}
+
+var internalProblem = problemKind{http.StatusInternalServerError, "internal-error", "Internal server error"}
+
+type requestPathKey struct{}
+
+// requestPathMiddleware makes the request path available to handlers, so that
+// problems can reference it as their instance.
+func requestPathMiddleware(f gen.StrictHandlerFunc, _ string) gen.StrictHandlerFunc {
+	return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return f(context.WithValue(ctx, requestPathKey{}, r.URL.Path), w, r, request)
+	}
+}
+
+type acceptKey struct{}
+
+// acceptMiddleware makes the Accept header available to handlers that
+// negotiate the media type of their response.
+func acceptMiddleware(f gen.StrictHandlerFunc, _ string) gen.StrictHandlerFunc {
+	return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return f(context.WithValue(ctx, acceptKey{}, r.Header.Get("Accept")), w, r, request)
+	}
+}
+
+// newProblem builds the problem for err. Errors that are not apperrors
+// sentinels are reported as internal errors without details.
+func newProblem(ctx context.Context, err error) gen.Problem {
+	kind := internalProblem
+	var detail string
+	for _, k := range problemKinds {
+		if errors.Is(err, k.err) {
+			kind = k.kind
+			detail = k.err.Error()
+			break
+		}
+	}
+	instance, _ := ctx.Value(requestPathKey{}).(string)
+	p := problem(ctx, kind, detail, instance)
+
+	var fieldErr *apperrors.FieldError
+	if errors.As(err, &fieldErr) {
+		detail := fieldErr.Error()
+		p.Detail = &detail
+		p.Errors = &[]gen.FieldError{{Field: fieldErr.Field, Message: fieldErr.Err.Error()}}
+	}
+	return p
+}
+
+// isInvalidField reports whether err rejects a field value of the request.
+func isInvalidField(err error) bool {
+	return errors.Is(err, apperrors.ErrInvalidField)
+}
+
+// isConflict reports whether err is a conflict with the stored data.
+func isConflict(err error) bool {
+	return errors.Is(err, apperrors.ErrConflictingField) || errors.Is(err, apperrors.ErrConstraintViolation)
+}
+
+func problem(ctx context.Context, kind problemKind, detail, instance string) gen.Problem {
+	p := gen.Problem{
+		Type:   problemTypePrefix + kind.slug,
+		Title:  kind.title,
+		Status: kind.status,
+	}
+	if detail != "" {
+		p.Detail = &detail
+	}
+	if instance != "" {
+		p.Instance = &instance
+	}
+	if id := logger.RequestID(ctx); id != "" {
+		p.RequestId = &id
+	}
+	return p
+}
+
+// problemWithStatus builds the problem for err as answered with status. Errors
+// like apperrors.ErrTeamNotFound are answered differently depending on the
+// operation, and the body must carry the status actually sent.
+func problemWithStatus(ctx context.Context, err error, status int) gen.Problem {
+	p := newProblem(ctx, err)
+	p.Status = status
+	return p
+}
+
+func badRequest(ctx context.Context, err error) gen.BadRequestApplicationProblemPlusJSONResponse {
+	return gen.BadRequestApplicationProblemPlusJSONResponse(problemWithStatus(ctx, err, http.StatusBadRequest))
+}
+
+func forbidden(ctx context.Context, err error) gen.ForbiddenApplicationProblemPlusJSONResponse {
+	return gen.ForbiddenApplicationProblemPlusJSONResponse(problemWithStatus(ctx, err, http.StatusForbidden))
+}
+
+func notFound(ctx context.Context, err error) gen.NotFoundApplicationProblemPlusJSONResponse {
+	return gen.NotFoundApplicationProblemPlusJSONResponse(problemWithStatus(ctx, err, http.StatusNotFound))
+}
+
+func conflict(ctx context.Context, err error) gen.ConflictApplicationProblemPlusJSONResponse {
+	return gen.ConflictApplicationProblemPlusJSONResponse(problemWithStatus(ctx, err, http.StatusConflict))
+}
+
+func preconditionFailed(ctx context.Context, err error) gen.PreconditionFailedApplicationProblemPlusJSONResponse {
+	return gen.PreconditionFailedApplicationProblemPlusJSONResponse(problemWithStatus(ctx, err, http.StatusPreconditionFailed))
+}
+
+func preconditionRequired(ctx context.Context, err error) gen.PreconditionRequiredApplicationProblemPlusJSONResponse {
+	return gen.PreconditionRequiredApplicationProblemPlusJSONResponse(problemWithStatus(ctx, err, http.StatusPreconditionRequired))
+}
+
+// writeProblem writes p as the response.
+func writeProblem(w http.ResponseWriter, p gen.Problem) {
+	w.Header().Set("Content-Type", problemContentType)
+	w.WriteHeader(p.Status)
+	_ = json.NewEncoder(w).Encode(p)
+}
+
+// RequestErrorHandler answers requests the strict handler could not decode.
+// The decoding error is kept for the access log.
+func RequestErrorHandler(w http.ResponseWriter, r *http.Request, err error) {
+	middleware.RecordError(r.Context(), err)
+	kind := problemKind{http.StatusBadRequest, "invalid-request", "Invalid request"}
+	writeProblem(w, problem(r.Context(), kind, err.Error(), r.URL.Path))
+}
+
+// ResponseErrorHandler answers requests whose handler returned an error.
+// The cause is written to the access log instead of the response body.
+// A stream that failed after its status was sent is aborted instead, so that
+// the client sees a truncated transfer rather than a complete one.
+func ResponseErrorHandler(w http.ResponseWriter, r *http.Request, err error) {
+	middleware.RecordError(r.Context(), err)
+	if errors.Is(err, errStreamAborted) {
+		panic(http.ErrAbortHandler)
+	}
+	writeProblem(w, problem(r.Context(), internalProblem, "", r.URL.Path))
+}
+
+// NewStrictHandler wraps the server into a gen.ServerInterface that reports
+// errors as problems.
+func NewStrictHandler(server gen.StrictServerInterface) gen.ServerInterface {
+	return gen.NewStrictHandlerWithOptions(server, []gen.StrictMiddlewareFunc{requestPathMiddleware, acceptMiddleware}, gen.StrictHTTPServerOptions{
+		RequestErrorHandlerFunc:  RequestErrorHandler,
+		ResponseErrorHandlerFunc: ResponseErrorHandler,
+	})
+}
@@ -0,0 +1,223 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/arsnazarenko/devops-basketball/api/gen"
+	"github.com/arsnazarenko/devops-basketball/internal/apperrors"
+	"github.com/arsnazarenko/devops-basketball/internal/auth"
+	"github.com/arsnazarenko/devops-basketball/internal/usecase"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func decodeProblem(t *testing.T, resp *http.Response) gen.Problem {
+	t.Helper()
+	require.Equal(t, problemContentType, resp.Header.Get("Content-Type"))
+	var p gen.Problem
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&p))
+	require.Equal(t, resp.StatusCode, p.Status)
+	return p
+}
+
+func TestProblemResponses(t *testing.T) {
+	mockUC := &MockPlayer{}
+	server := setupTestServer(mockUC)
+	defer server.Close()
+
+	t.Run("validation errors list invalid fields", func(t *testing.T) {
+		body := []byte(`{"name":"John","surname":"Doe","age":12,"height":1900,"weight":85000,"citizenship":"U","role":"PG","teamId":1}`)
+		resp, err := http.Post(server.URL+"/players", "application/json", bytes.NewReader(body))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		p := decodeProblem(t, resp)
+		require.Equal(t, "urn:problem:validation-error", p.Type)
+		require.NotNil(t, p.Instance)
+		require.Equal(t, "/players", *p.Instance)
+		require.NotNil(t, p.Errors)
+
+		fields := map[string]bool{}
+		for _, e := range *p.Errors {
+			fields[e.Field] = true
+		}
+		require.Equal(t, map[string]bool{"age": true, "citizenship": true}, fields)
+	})
+
+	t.Run("invalid query parameter", func(t *testing.T) {
+		resp, err := http.Get(server.URL + "/players?page_size=1000")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		p := decodeProblem(t, resp)
+		require.NotNil(t, p.Errors)
+		require.Equal(t, "page_size", (*p.Errors)[0].Field)
+	})
+
+	t.Run("application error", func(t *testing.T) {
+		mockUC.On("GetPlayer", mock.Anything, int64(42)).Return((*gen.Player)(nil), apperrors.ErrPlayerNotFound).Once()
+
+		req, _ := http.NewRequest(http.MethodGet, server.URL+"/players/42", nil)
+		req.Header.Set("X-Request-ID", "req-42")
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+		p := decodeProblem(t, resp)
+		require.Equal(t, "urn:problem:player-not-found", p.Type)
+		require.Equal(t, apperrors.ErrPlayerNotFound.Error(), *p.Detail)
+		require.Equal(t, "/players/42", *p.Instance)
+		mockUC.AssertExpectations(t)
+	})
+
+	t.Run("internal error hides the cause", func(t *testing.T) {
+		mockUC.On("GetPlayer", mock.Anything, int64(43)).Return((*gen.Player)(nil), errors.New("connection refused")).Once()
+
+		resp, err := http.Get(server.URL + "/players/43")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+		p := decodeProblem(t, resp)
+		require.Equal(t, "urn:problem:internal-error", p.Type)
+		require.Nil(t, p.Detail)
+		mockUC.AssertExpectations(t)
+	})
+
+	t.Run("constraint violation", func(t *testing.T) {
+		playerCreate := &gen.PlayerCreate{
+			Name:        "John",
+			Surname:     "Doe",
+			Age:         25,
+			Height:      1900,
+			Weight:      85000,
+			Citizenship: "USA",
+			Role:        "PG",
+			TeamId:      1,
+		}
+		mockUC.On("CreatePlayer", mock.Anything, playerCreate).Return((*gen.Player)(nil), apperrors.ErrConstraintViolation).Once()
+
+		body, _ := json.Marshal(playerCreate)
+		resp, err := http.Post(server.URL+"/players", "application/json", bytes.NewReader(body))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusConflict, resp.StatusCode)
+		p := decodeProblem(t, resp)
+		require.Equal(t, "urn:problem:constraint-violation", p.Type)
+		mockUC.AssertExpectations(t)
+	})
+}
+
+func TestFieldErrorProblem(t *testing.T) {
+	mockUC := &MockPlayer{}
+	server := setupTestServer(mockUC)
+	defer server.Close()
+
+	cases := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"check violation", &apperrors.FieldError{Field: "age", Constraint: "players_age_check", Err: apperrors.ErrInvalidField}, http.StatusBadRequest},
+		{"unique violation", &apperrors.FieldError{Field: "name", Constraint: "players_name_key", Err: apperrors.ErrConflictingField}, http.StatusConflict},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			update := &gen.PlayerUpdate{}
+			mockUC.On("UpdatePlayer", mock.Anything, int64(1), usecase.AnyVersion, update).Return((*gen.Player)(nil), tc.err).Once()
+
+			req, _ := http.NewRequest(http.MethodPut, server.URL+"/players/1", bytes.NewReader([]byte(`{}`)))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("If-Match", "*")
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			require.Equal(t, tc.status, resp.StatusCode)
+			p := decodeProblem(t, resp)
+			require.NotNil(t, p.Errors)
+			fieldErr := tc.err.(*apperrors.FieldError)
+			require.Equal(t, []gen.FieldError{{Field: fieldErr.Field, Message: fieldErr.Err.Error()}}, *p.Errors)
+			mockUC.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUnauthorizedProblem(t *testing.T) {
+	swagger, err := gen.GetSwagger()
+	require.NoError(t, err)
+	swagger.Servers = nil
+
+	r := chi.NewRouter()
+	r.Use(RequestValidator(swagger, auth.AuthenticationFunc))
+	gen.HandlerFromMux(NewStrictHandler(NewServer(&MockPlayer{}, &MockTeam{}, &MockGame{})), r)
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/players/1")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	require.Contains(t, resp.Header.Get("WWW-Authenticate"), "Bearer")
+	p := decodeProblem(t, resp)
+	require.Equal(t, "urn:problem:unauthorized", p.Type)
+	require.Equal(t, auth.ErrUnauthenticated.Error(), *p.Detail)
+}
+
+func TestAuthUnavailableProblem(t *testing.T) {
+	swagger, err := gen.GetSwagger()
+	require.NoError(t, err)
+	swagger.Servers = nil
+
+	authenticate := func(context.Context, *openapi3filter.AuthenticationInput) error {
+		return fmt.Errorf("auth.Authenticate: %w: %w", auth.ErrUnavailable, errors.New("connection refused"))
+	}
+	r := chi.NewRouter()
+	r.Use(RequestValidator(swagger, authenticate))
+	gen.HandlerFromMux(NewStrictHandler(NewServer(&MockPlayer{}, &MockTeam{}, &MockGame{})), r)
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/players/1")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	require.Empty(t, resp.Header.Get("WWW-Authenticate"))
+	p := decodeProblem(t, resp)
+	require.Equal(t, "urn:problem:internal-error", p.Type)
+	require.Equal(t, http.StatusInternalServerError, p.Status)
+}
+
+func TestForbiddenProblem(t *testing.T) {
+	mockUC := &MockPlayer{}
+	server := setupTestServer(mockUC)
+	defer server.Close()
+
+	mockUC.On("DeletePlayer", mock.Anything, int64(5), int64(2)).Return(apperrors.ErrForbidden).Once()
+
+	req, _ := http.NewRequest(http.MethodDelete, server.URL+"/players/5", nil)
+	req.Header.Set("If-Match", `"2"`)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusForbidden, resp.StatusCode)
+	p := decodeProblem(t, resp)
+	require.Equal(t, "urn:problem:forbidden", p.Type)
+	mockUC.AssertExpectations(t)
+}
@@ -0,0 +1,23 @@
+package v1
+
+import (
+	"github.com/arsnazarenko/devops-basketball/api/gen"
+	"github.com/arsnazarenko/devops-basketball/internal/usecase"
+)
+
+var _ gen.StrictServerInterface = (*Server)(nil)
+
+// Server implements gen.StrictServerInterface by composing the per-resource handlers.
+type Server struct {
+	*PlayersServerImpl
+	*TeamsServerImpl
+	*GamesServerImpl
+}
+
+func NewServer(player usecase.Player, team usecase.Team, game usecase.Game) *Server {
+	return &Server{
+		PlayersServerImpl: NewPlayersServerImpl(player),
+		TeamsServerImpl:   NewTeamsServerImpl(team),
+		GamesServerImpl:   NewGamesServerImpl(game),
+	}
+}
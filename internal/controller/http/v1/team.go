@@ -0,0 +1,122 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/arsnazarenko/devops-basketball/api/gen"
+	"github.com/arsnazarenko/devops-basketball/internal/apperrors"
+	"github.com/arsnazarenko/devops-basketball/internal/usecase"
+)
+
+type TeamsServerImpl struct {
+	uc usecase.Team
+}
+
+func NewTeamsServerImpl(uc usecase.Team) *TeamsServerImpl {
+	return &TeamsServerImpl{
+		uc: uc,
+	}
+}
+
+// CreateTeam implements gen.StrictServerInterface.
+func (t *TeamsServerImpl) CreateTeam(ctx context.Context, request gen.CreateTeamRequestObject) (gen.CreateTeamResponseObject, error) {
+	created, err := t.uc.CreateTeam(ctx, request.Body)
+	if errors.Is(err, apperrors.ErrForbidden) {
+		return gen.CreateTeam403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx, err)}, nil
+	}
+	if isInvalidField(err) {
+		return gen.CreateTeam400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: badRequest(ctx, err)}, nil
+	}
+	if isConflict(err) {
+		return gen.CreateTeam409ApplicationProblemPlusJSONResponse{ConflictApplicationProblemPlusJSONResponse: conflict(ctx, err)}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return gen.CreateTeam201JSONResponse(*created), nil
+}
+
+// DeleteTeam implements gen.StrictServerInterface.
+func (t *TeamsServerImpl) DeleteTeam(ctx context.Context, request gen.DeleteTeamRequestObject) (gen.DeleteTeamResponseObject, error) {
+	err := t.uc.DeleteTeam(ctx, request.Id)
+	if errors.Is(err, apperrors.ErrForbidden) {
+		return gen.DeleteTeam403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx, err)}, nil
+	}
+	if errors.Is(err, apperrors.ErrTeamNotFound) {
+		return gen.DeleteTeam404ApplicationProblemPlusJSONResponse{NotFoundApplicationProblemPlusJSONResponse: notFound(ctx, err)}, nil
+	}
+	if errors.Is(err, apperrors.ErrTeamHasPlayers) || errors.Is(err, apperrors.ErrTeamHasGames) {
+		return gen.DeleteTeam409ApplicationProblemPlusJSONResponse{ConflictApplicationProblemPlusJSONResponse: conflict(ctx, err)}, nil
+	}
+	if isConflict(err) {
+		return gen.DeleteTeam409ApplicationProblemPlusJSONResponse{ConflictApplicationProblemPlusJSONResponse: conflict(ctx, err)}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return gen.DeleteTeam204Response{}, nil
+}
+
+// GetTeam implements gen.StrictServerInterface.
+func (t *TeamsServerImpl) GetTeam(ctx context.Context, request gen.GetTeamRequestObject) (gen.GetTeamResponseObject, error) {
+	team, err := t.uc.GetTeam(ctx, request.Id)
+	if errors.Is(err, apperrors.ErrForbidden) {
+		return gen.GetTeam403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx, err)}, nil
+	}
+	if errors.Is(err, apperrors.ErrTeamNotFound) {
+		return gen.GetTeam404ApplicationProblemPlusJSONResponse{NotFoundApplicationProblemPlusJSONResponse: notFound(ctx, err)}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return gen.GetTeam200JSONResponse(*team), nil
+}
+
+// ListTeams implements gen.StrictServerInterface.
+func (t *TeamsServerImpl) ListTeams(ctx context.Context, request gen.ListTeamsRequestObject) (gen.ListTeamsResponseObject, error) {
+	var (
+		pageSize   uint64 = defaultPageSize
+		pageNumber uint64 = defaultPageNumber
+	)
+
+	if request.Params.PageNumber != nil {
+		pageNumber = uint64(*request.Params.PageNumber)
+	}
+	if request.Params.PageSize != nil {
+		pageSize = uint64(*request.Params.PageSize)
+	}
+
+	list, err := t.uc.GetTeamList(ctx, pageSize, pageNumber)
+	if errors.Is(err, apperrors.ErrForbidden) {
+		return gen.ListTeams403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx, err)}, nil
+	}
+	if errors.Is(err, apperrors.ErrInvalidTeamPageNumber) || errors.Is(err, apperrors.ErrInvalidTeamPageSize) {
+		return gen.ListTeams400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: badRequest(ctx, err)}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return gen.ListTeams200JSONResponse(list), nil
+}
+
+// UpdateTeam implements gen.StrictServerInterface.
+func (t *TeamsServerImpl) UpdateTeam(ctx context.Context, request gen.UpdateTeamRequestObject) (gen.UpdateTeamResponseObject, error) {
+	updated, err := t.uc.UpdateTeam(ctx, request.Id, request.Body)
+	if errors.Is(err, apperrors.ErrForbidden) {
+		return gen.UpdateTeam403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx, err)}, nil
+	}
+	if errors.Is(err, apperrors.ErrTeamNotFound) {
+		return gen.UpdateTeam404ApplicationProblemPlusJSONResponse{NotFoundApplicationProblemPlusJSONResponse: notFound(ctx, err)}, nil
+	}
+	if isInvalidField(err) {
+		return gen.UpdateTeam400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: badRequest(ctx, err)}, nil
+	}
+	if isConflict(err) {
+		return gen.UpdateTeam409ApplicationProblemPlusJSONResponse{ConflictApplicationProblemPlusJSONResponse: conflict(ctx, err)}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return gen.UpdateTeam200JSONResponse(*updated), nil
+}
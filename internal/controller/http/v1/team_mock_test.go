@@ -0,0 +1,38 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/arsnazarenko/devops-basketball/api/gen"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockTeam is a mock implementation of usecase.Team interface
+type MockTeam struct {
+	mock.Mock
+}
+
+func (m *MockTeam) CreateTeam(ctx context.Context, team *gen.TeamCreate) (*gen.Team, error) {
+	args := m.Called(ctx, team)
+	return args.Get(0).(*gen.Team), args.Error(1)
+}
+
+func (m *MockTeam) UpdateTeam(ctx context.Context, teamID int64, team *gen.TeamUpdate) (*gen.Team, error) {
+	args := m.Called(ctx, teamID, team)
+	return args.Get(0).(*gen.Team), args.Error(1)
+}
+
+func (m *MockTeam) DeleteTeam(ctx context.Context, teamID int64) error {
+	args := m.Called(ctx, teamID)
+	return args.Error(0)
+}
+
+func (m *MockTeam) GetTeam(ctx context.Context, teamID int64) (*gen.Team, error) {
+	args := m.Called(ctx, teamID)
+	return args.Get(0).(*gen.Team), args.Error(1)
+}
+
+func (m *MockTeam) GetTeamList(ctx context.Context, pageSize, pageNumber uint64) ([]gen.Team, error) {
+	args := m.Called(ctx, pageSize, pageNumber)
+	return args.Get(0).([]gen.Team), args.Error(1)
+}
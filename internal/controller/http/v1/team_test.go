@@ -0,0 +1,251 @@
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/arsnazarenko/devops-basketball/api/gen"
+	"github.com/arsnazarenko/devops-basketball/internal/apperrors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTeamTestServer(mockUC *MockTeam) *httptest.Server {
+	return newTestServer(&MockPlayer{}, mockUC, &MockGame{})
+}
+
+func TestCreateTeam(t *testing.T) {
+	mockUC := &MockTeam{}
+	server := setupTeamTestServer(mockUC)
+	defer server.Close()
+
+	t.Run("success", func(t *testing.T) {
+		teamCreate := &gen.TeamCreate{
+			Name: "Los Angeles Lakers",
+			City: "Los Angeles",
+		}
+		expectedTeam := &gen.Team{
+			Id:   1,
+			Name: "Los Angeles Lakers",
+			City: "Los Angeles",
+		}
+
+		mockUC.On("CreateTeam", mock.Anything, teamCreate).Return(expectedTeam, nil).Once()
+
+		body, _ := json.Marshal(teamCreate)
+		resp, err := http.Post(server.URL+"/teams", "application/json", bytes.NewReader(body))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		var response gen.Team
+		err = json.NewDecoder(resp.Body).Decode(&response)
+		require.NoError(t, err)
+		require.Equal(t, *expectedTeam, response)
+
+		mockUC.AssertExpectations(t)
+	})
+
+	t.Run("missing city", func(t *testing.T) {
+		body := []byte(`{"name":"Lakers"}`)
+		resp, err := http.Post(server.URL+"/teams", "application/json", bytes.NewReader(body))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		teamCreate := &gen.TeamCreate{Name: "Chicago Bulls", City: "Chicago"}
+		mockUC.On("CreateTeam", mock.Anything, teamCreate).Return((*gen.Team)(nil), apperrors.ErrForbidden).Once()
+
+		body, _ := json.Marshal(teamCreate)
+		resp, err := http.Post(server.URL+"/teams", "application/json", bytes.NewReader(body))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusForbidden, resp.StatusCode)
+		p := decodeProblem(t, resp)
+		require.Equal(t, "urn:problem:forbidden", p.Type)
+
+		mockUC.AssertExpectations(t)
+	})
+}
+
+func TestGetTeam(t *testing.T) {
+	mockUC := &MockTeam{}
+	server := setupTeamTestServer(mockUC)
+	defer server.Close()
+
+	t.Run("success", func(t *testing.T) {
+		expectedTeam := &gen.Team{Id: 1, Name: "Boston Celtics", City: "Boston"}
+
+		mockUC.On("GetTeam", mock.Anything, int64(1)).Return(expectedTeam, nil).Once()
+
+		resp, err := http.Get(server.URL + "/teams/1")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var response gen.Team
+		err = json.NewDecoder(resp.Body).Decode(&response)
+		require.NoError(t, err)
+		require.Equal(t, *expectedTeam, response)
+
+		mockUC.AssertExpectations(t)
+	})
+
+	t.Run("team not found", func(t *testing.T) {
+		mockUC.On("GetTeam", mock.Anything, int64(999)).Return((*gen.Team)(nil), apperrors.ErrTeamNotFound).Once()
+
+		resp, err := http.Get(server.URL + "/teams/999")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+		mockUC.AssertExpectations(t)
+	})
+}
+
+func TestListTeams(t *testing.T) {
+	mockUC := &MockTeam{}
+	server := setupTeamTestServer(mockUC)
+	defer server.Close()
+
+	expectedTeams := []gen.Team{
+		{Id: 1, Name: "Boston Celtics", City: "Boston"},
+		{Id: 2, Name: "Chicago Bulls", City: "Chicago"},
+	}
+
+	mockUC.On("GetTeamList", mock.Anything, uint64(10), uint64(2)).Return(expectedTeams, nil).Once()
+
+	resp, err := http.Get(server.URL + "/teams?page_size=10&page_number=2")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var response []gen.Team
+	err = json.NewDecoder(resp.Body).Decode(&response)
+	require.NoError(t, err)
+	require.Equal(t, expectedTeams, response)
+
+	mockUC.AssertExpectations(t)
+}
+
+func TestUpdateTeam(t *testing.T) {
+	mockUC := &MockTeam{}
+	server := setupTeamTestServer(mockUC)
+	defer server.Close()
+
+	teamUpdate := &gen.TeamUpdate{Name: "Brooklyn Nets", City: "Brooklyn"}
+
+	t.Run("success", func(t *testing.T) {
+		expectedTeam := &gen.Team{Id: 3, Name: "Brooklyn Nets", City: "Brooklyn"}
+
+		mockUC.On("UpdateTeam", mock.Anything, int64(3), teamUpdate).Return(expectedTeam, nil).Once()
+
+		body, _ := json.Marshal(teamUpdate)
+		req, _ := http.NewRequest(http.MethodPut, server.URL+"/teams/3", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		mockUC.AssertExpectations(t)
+	})
+
+	t.Run("team not found", func(t *testing.T) {
+		mockUC.On("UpdateTeam", mock.Anything, int64(999), teamUpdate).Return((*gen.Team)(nil), apperrors.ErrTeamNotFound).Once()
+
+		body, _ := json.Marshal(teamUpdate)
+		req, _ := http.NewRequest(http.MethodPut, server.URL+"/teams/999", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+		mockUC.AssertExpectations(t)
+	})
+}
+
+func TestDeleteTeam(t *testing.T) {
+	mockUC := &MockTeam{}
+	server := setupTeamTestServer(mockUC)
+	defer server.Close()
+
+	t.Run("success", func(t *testing.T) {
+		mockUC.On("DeleteTeam", mock.Anything, int64(1)).Return(nil).Once()
+
+		req, _ := http.NewRequest(http.MethodDelete, server.URL+"/teams/1", nil)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		mockUC.AssertExpectations(t)
+	})
+
+	t.Run("team has players", func(t *testing.T) {
+		mockUC.On("DeleteTeam", mock.Anything, int64(2)).Return(apperrors.ErrTeamHasPlayers).Once()
+
+		req, _ := http.NewRequest(http.MethodDelete, server.URL+"/teams/2", nil)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusConflict, resp.StatusCode)
+
+		mockUC.AssertExpectations(t)
+	})
+
+	t.Run("team has games", func(t *testing.T) {
+		mockUC.On("DeleteTeam", mock.Anything, int64(3)).Return(apperrors.ErrTeamHasGames).Once()
+
+		req, _ := http.NewRequest(http.MethodDelete, server.URL+"/teams/3", nil)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusConflict, resp.StatusCode)
+
+		mockUC.AssertExpectations(t)
+	})
+
+	t.Run("team not found", func(t *testing.T) {
+		mockUC.On("DeleteTeam", mock.Anything, int64(999)).Return(apperrors.ErrTeamNotFound).Once()
+
+		req, _ := http.NewRequest(http.MethodDelete, server.URL+"/teams/999", nil)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+		mockUC.AssertExpectations(t)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		mockUC.On("DeleteTeam", mock.Anything, int64(4)).Return(apperrors.ErrForbidden).Once()
+
+		req, _ := http.NewRequest(http.MethodDelete, server.URL+"/teams/4", nil)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+		mockUC.AssertExpectations(t)
+	})
+}
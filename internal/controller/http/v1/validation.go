@@ -0,0 +1,130 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/arsnazarenko/devops-basketball/api/gen"
+	"github.com/arsnazarenko/devops-basketball/internal/apperrors"
+	"github.com/arsnazarenko/devops-basketball/internal/auth"
+	"github.com/arsnazarenko/devops-basketball/internal/controller/http/middleware"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	nethttpmiddleware "github.com/oapi-codegen/nethttp-middleware"
+)
+
+// RequestValidator validates requests against the OpenAPI spec and reports
+// failures as problems listing every invalid field. Security requirements of
+// operations are checked with authenticate.
+func RequestValidator(swagger *openapi3.T, authenticate openapi3filter.AuthenticationFunc) func(http.Handler) http.Handler {
+	return nethttpmiddleware.OapiRequestValidatorWithOptions(swagger, &nethttpmiddleware.Options{
+		Options: openapi3filter.Options{
+			MultiError:         true,
+			AuthenticationFunc: authenticate,
+		},
+		ErrorHandlerWithOpts: validationErrorHandler,
+	})
+}
+
+func validationErrorHandler(ctx context.Context, err error, w http.ResponseWriter, r *http.Request, opts nethttpmiddleware.ErrorHandlerOpts) {
+	middleware.RecordError(ctx, err)
+
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		// only uploads are limited, see LimitImportSize
+		writeProblem(w, newProblem(context.WithValue(ctx, requestPathKey{}, r.URL.Path), apperrors.ErrImportTooLarge))
+		return
+	}
+
+	kind := problemKind{opts.StatusCode, "validation-error", "Invalid request"}
+	switch opts.StatusCode {
+	case http.StatusNotFound:
+		kind = problemKind{http.StatusNotFound, "not-found", http.StatusText(http.StatusNotFound)}
+	case http.StatusMethodNotAllowed:
+		kind = problemKind{http.StatusMethodNotAllowed, "method-not-allowed", http.StatusText(http.StatusMethodNotAllowed)}
+	case http.StatusUnauthorized:
+		kind = problemKind{http.StatusUnauthorized, "unauthorized", http.StatusText(http.StatusUnauthorized)}
+		// the credentials could not be checked, the caller is not to blame
+		if errors.Is(err, auth.ErrUnavailable) {
+			kind = internalProblem
+		}
+	case http.StatusInternalServerError:
+		kind = internalProblem
+	}
+
+	var detail string
+	if kind.status == http.StatusUnauthorized {
+		detail = authErrorDetail(err)
+		w.Header().Set("WWW-Authenticate", `Bearer realm="devops-basketball"`)
+	}
+
+	p := problem(ctx, kind, detail, r.URL.Path)
+	if kind.status == http.StatusBadRequest {
+		fields := fieldErrors(nil, err)
+		if len(fields) > 0 {
+			p.Errors = &fields
+		}
+	}
+	writeProblem(w, p)
+}
+
+// authErrorDetail explains why the security requirements were not met.
+func authErrorDetail(err error) string {
+	var secErr *openapi3filter.SecurityRequirementsError
+	if errors.As(err, &secErr) {
+		for _, e := range secErr.Errors {
+			if errors.Is(e, auth.ErrInvalidToken) {
+				return auth.ErrInvalidToken.Error()
+			}
+		}
+	}
+	return auth.ErrUnauthenticated.Error()
+}
+
+// fieldErrors flattens validation errors into per-field errors.
+func fieldErrors(dst []gen.FieldError, err error) []gen.FieldError {
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		for _, err := range e {
+			dst = fieldErrors(dst, err)
+		}
+		return dst
+	case *openapi3filter.RequestError:
+		switch {
+		case e.Err == nil:
+			return append(dst, gen.FieldError{Field: requestErrorField(e), Message: e.Reason})
+		case e.Parameter != nil:
+			return causeFieldErrors(dst, e.Parameter.Name, false, e.Err)
+		default:
+			return causeFieldErrors(dst, "body", e.RequestBody != nil, e.Err)
+		}
+	}
+	return append(dst, gen.FieldError{Field: "", Message: err.Error()})
+}
+
+// causeFieldErrors reports the causes of a request error. Schema errors of the
+// request body are reported by the JSON pointer of the invalid field.
+func causeFieldErrors(dst []gen.FieldError, field string, body bool, err error) []gen.FieldError {
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		for _, err := range e {
+			dst = causeFieldErrors(dst, field, body, err)
+		}
+		return dst
+	case *openapi3.SchemaError:
+		if pointer := e.JSONPointer(); body && len(pointer) > 0 {
+			return append(dst, gen.FieldError{Field: strings.Join(pointer, "/"), Message: e.Reason})
+		}
+		return append(dst, gen.FieldError{Field: field, Message: e.Reason})
+	}
+	return append(dst, gen.FieldError{Field: field, Message: err.Error()})
+}
+
+func requestErrorField(e *openapi3filter.RequestError) string {
+	if e.Parameter != nil {
+		return e.Parameter.Name
+	}
+	return "body"
+}
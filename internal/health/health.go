@@ -0,0 +1,125 @@
+// Package health serves liveness and readiness probes
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	statusOK   = "ok"
+	statusFail = "fail"
+)
+
+var errShuttingDown = errors.New("service is shutting down")
+
+// Check reports an error when a dependency is not ready.
+type Check func(ctx context.Context) error
+
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+// Checker runs readiness checks. It is not ready once shutdown has started.
+type Checker struct {
+	timeout      time.Duration
+	checks       []namedCheck
+	shuttingDown atomic.Bool
+}
+
+// CheckResult is the outcome of a single check.
+type CheckResult struct {
+	Status    string  `json:"status"`
+	LatencyMs float64 `json:"latency_ms"`
+	Error     string  `json:"error,omitempty"`
+}
+
+// Response is the body of the probe endpoints.
+type Response struct {
+	Status string                 `json:"status"`
+	Checks map[string]CheckResult `json:"checks,omitempty"`
+}
+
+// New creates a Checker bounding each readiness probe by timeout.
+func New(timeout time.Duration) *Checker {
+	return &Checker{
+		timeout: timeout,
+	}
+}
+
+// Add registers a readiness check. It must be called before serving probes.
+func (c *Checker) Add(name string, check Check) {
+	c.checks = append(c.checks, namedCheck{name: name, check: check})
+}
+
+// SetShuttingDown makes readiness fail, so traffic is drained from the instance.
+func (c *Checker) SetShuttingDown() {
+	c.shuttingDown.Store(true)
+}
+
+// Liveness reports that the process is alive and serving HTTP.
+func (c *Checker) Liveness(w http.ResponseWriter, _ *http.Request) {
+	writeResponse(w, http.StatusOK, Response{Status: statusOK})
+}
+
+// Readiness runs all checks concurrently and reports 503 when any of them fails.
+func (c *Checker) Readiness(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), c.timeout)
+	defer cancel()
+
+	checks := append([]namedCheck{{name: "shutdown", check: c.checkShutdown}}, c.checks...)
+	results := make([]CheckResult, len(checks))
+
+	var wg sync.WaitGroup
+	for i, nc := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			start := time.Now()
+			err := nc.check(ctx)
+			results[i] = CheckResult{
+				Status:    statusOK,
+				LatencyMs: float64(time.Since(start).Microseconds()) / 1000,
+			}
+			if err != nil {
+				results[i].Status = statusFail
+				results[i].Error = err.Error()
+			}
+		}()
+	}
+	wg.Wait()
+
+	resp := Response{
+		Status: statusOK,
+		Checks: make(map[string]CheckResult, len(checks)),
+	}
+	code := http.StatusOK
+	for i, nc := range checks {
+		resp.Checks[nc.name] = results[i]
+		if results[i].Status != statusOK {
+			resp.Status = statusFail
+			code = http.StatusServiceUnavailable
+		}
+	}
+	writeResponse(w, code, resp)
+}
+
+func (c *Checker) checkShutdown(context.Context) error {
+	if c.shuttingDown.Load() {
+		return errShuttingDown
+	}
+	return nil
+}
+
+func writeResponse(w http.ResponseWriter, code int, resp Response) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,76 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func probe(t *testing.T, handler http.HandlerFunc) (int, Response) {
+	rec := httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	var resp Response
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	return rec.Code, resp
+}
+
+func TestLiveness(t *testing.T) {
+	c := New(time.Second)
+	c.Add("postgres", func(context.Context) error { return errors.New("down") })
+
+	code, resp := probe(t, c.Liveness)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, statusOK, resp.Status)
+}
+
+func TestReadiness(t *testing.T) {
+	t.Run("ready", func(t *testing.T) {
+		c := New(time.Second)
+		c.Add("postgres", func(context.Context) error { return nil })
+
+		code, resp := probe(t, c.Readiness)
+		require.Equal(t, http.StatusOK, code)
+		require.Equal(t, statusOK, resp.Status)
+		require.Equal(t, statusOK, resp.Checks["postgres"].Status)
+		require.Equal(t, statusOK, resp.Checks["shutdown"].Status)
+	})
+
+	t.Run("failing check", func(t *testing.T) {
+		c := New(time.Second)
+		c.Add("postgres", func(context.Context) error { return nil })
+		c.Add("migrations", func(context.Context) error { return errors.New("pending migrations") })
+
+		code, resp := probe(t, c.Readiness)
+		require.Equal(t, http.StatusServiceUnavailable, code)
+		require.Equal(t, statusFail, resp.Status)
+		require.Equal(t, statusOK, resp.Checks["postgres"].Status)
+		require.Equal(t, "pending migrations", resp.Checks["migrations"].Error)
+	})
+
+	t.Run("check timeout", func(t *testing.T) {
+		c := New(10 * time.Millisecond)
+		c.Add("postgres", func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		})
+
+		code, _ := probe(t, c.Readiness)
+		require.Equal(t, http.StatusServiceUnavailable, code)
+	})
+
+	t.Run("shutting down", func(t *testing.T) {
+		c := New(time.Second)
+		c.SetShuttingDown()
+
+		code, resp := probe(t, c.Readiness)
+		require.Equal(t, http.StatusServiceUnavailable, code)
+		require.Equal(t, statusFail, resp.Checks["shutdown"].Status)
+	})
+}
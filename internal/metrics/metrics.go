@@ -36,6 +36,25 @@ var (
 		},
 		[]string{"method", "endpoint"},
 	)
+
+	// DBQueryDuration measures the duration of repository methods
+	DBQueryDuration = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "db_query_duration_seconds",
+			Help:    "Duration of repository queries in seconds",
+			Buckets: []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+		},
+		[]string{"method", "status"},
+	)
+
+	// DBQueryErrorsTotal counts failed repository methods
+	DBQueryErrorsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "db_query_errors_total",
+			Help: "Total number of failed repository queries",
+		},
+		[]string{"method"},
+	)
 )
 
 // RecordHTTPRequest records an HTTP request with its metrics
@@ -55,3 +74,13 @@ func IncrementInFlight(method, endpoint string) {
 func DecrementInFlight(method, endpoint string) {
 	HTTPRequestsInFlight.WithLabelValues(method, endpoint).Dec()
 }
+
+// RecordDBQuery records a repository method call. Status is "ok" or "error".
+func RecordDBQuery(method string, duration time.Duration, err error) {
+	status := "ok"
+	if err != nil {
+		status = "error"
+		DBQueryErrorsTotal.WithLabelValues(method).Inc()
+	}
+	DBQueryDuration.WithLabelValues(method, status).Observe(duration.Seconds())
+}
@@ -0,0 +1,34 @@
+package metrics
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordDBQuery(t *testing.T) {
+	RecordDBQuery("TestRepo.Get", time.Millisecond, nil)
+	RecordDBQuery("TestRepo.Get", time.Millisecond, errors.New("boom"))
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(DBQueryErrorsTotal.WithLabelValues("TestRepo.Get")))
+	assert.Equal(t, 2, testutil.CollectAndCount(DBQueryDuration, "db_query_duration_seconds"))
+}
+
+func TestRegisterRuntimeCollectors(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	require.NoError(t, RegisterRuntimeCollectors(reg))
+
+	families, err := reg.Gather()
+	require.NoError(t, err)
+	names := map[string]bool{}
+	for _, f := range families {
+		names[f.GetName()] = true
+	}
+	assert.True(t, names["go_build_info"])
+	assert.True(t, names["go_sched_gomaxprocs_threads"])
+}
@@ -0,0 +1,73 @@
+package metrics
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// PoolCollector exports the statistics of a pgx connection pool.
+type PoolCollector struct {
+	pool *pgxpool.Pool
+
+	acquiredConns        *prometheus.Desc
+	idleConns            *prometheus.Desc
+	constructingConns    *prometheus.Desc
+	totalConns           *prometheus.Desc
+	maxConns             *prometheus.Desc
+	acquireCount         *prometheus.Desc
+	acquireDuration      *prometheus.Desc
+	canceledAcquireCount *prometheus.Desc
+	emptyAcquireCount    *prometheus.Desc
+	emptyAcquireWait     *prometheus.Desc
+	newConnsCount        *prometheus.Desc
+}
+
+var _ prometheus.Collector = (*PoolCollector)(nil)
+
+func NewPoolCollector(pool *pgxpool.Pool) *PoolCollector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc("pgxpool_"+name, help, nil, nil)
+	}
+	return &PoolCollector{
+		pool:                 pool,
+		acquiredConns:        desc("acquired_conns", "Number of currently acquired connections"),
+		idleConns:            desc("idle_conns", "Number of currently idle connections"),
+		constructingConns:    desc("constructing_conns", "Number of connections being established"),
+		totalConns:           desc("total_conns", "Total number of connections in the pool"),
+		maxConns:             desc("max_conns", "Maximum size of the pool"),
+		acquireCount:         desc("acquires_total", "Number of successful connection acquires"),
+		acquireDuration:      desc("acquire_duration_seconds_total", "Total time spent acquiring connections"),
+		canceledAcquireCount: desc("canceled_acquires_total", "Number of acquires canceled by their context"),
+		emptyAcquireCount:    desc("empty_acquires_total", "Number of acquires that had to wait for a connection"),
+		emptyAcquireWait:     desc("empty_acquire_wait_seconds_total", "Total time acquires waited for a connection"),
+		newConnsCount:        desc("new_conns_total", "Number of connections opened"),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *PoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+// Collect implements prometheus.Collector.
+func (c *PoolCollector) Collect(ch chan<- prometheus.Metric) {
+	s := c.pool.Stat()
+	gauge := func(d *prometheus.Desc, v float64) {
+		ch <- prometheus.MustNewConstMetric(d, prometheus.GaugeValue, v)
+	}
+	counter := func(d *prometheus.Desc, v float64) {
+		ch <- prometheus.MustNewConstMetric(d, prometheus.CounterValue, v)
+	}
+
+	gauge(c.acquiredConns, float64(s.AcquiredConns()))
+	gauge(c.idleConns, float64(s.IdleConns()))
+	gauge(c.constructingConns, float64(s.ConstructingConns()))
+	gauge(c.totalConns, float64(s.TotalConns()))
+	gauge(c.maxConns, float64(s.MaxConns()))
+	counter(c.acquireCount, float64(s.AcquireCount()))
+	counter(c.acquireDuration, s.AcquireDuration().Seconds())
+	counter(c.canceledAcquireCount, float64(s.CanceledAcquireCount()))
+	counter(c.emptyAcquireCount, float64(s.EmptyAcquireCount()))
+	counter(c.emptyAcquireWait, s.EmptyAcquireWaitTime().Seconds())
+	counter(c.newConnsCount, float64(s.NewConnsCount()))
+}
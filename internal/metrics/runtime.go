@@ -0,0 +1,18 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+)
+
+// RegisterRuntimeCollectors replaces the default Go collector with one that
+// exports all runtime/metrics and adds the go_build_info metric.
+func RegisterRuntimeCollectors(reg prometheus.Registerer) error {
+	reg.Unregister(collectors.NewGoCollector())
+	if err := reg.Register(collectors.NewGoCollector(
+		collectors.WithGoCollectorRuntimeMetrics(collectors.MetricsAll),
+	)); err != nil {
+		return err
+	}
+	return reg.Register(collectors.NewBuildInfoCollector())
+}
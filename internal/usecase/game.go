@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/arsnazarenko/devops-basketball/api/gen"
+	"github.com/arsnazarenko/devops-basketball/internal/apperrors"
+)
+
+type GameUC struct {
+	r GameRp
+}
+
+func NewGameUsecase(repo GameRp) *GameUC {
+	return &GameUC{
+		r: repo,
+	}
+}
+
+var _ Game = (*GameUC)(nil)
+
+// CreateGame implements Game.
+func (g *GameUC) CreateGame(ctx context.Context, game *gen.GameCreate) (*gen.Game, error) {
+	status := gen.Scheduled
+	if game.Status != nil {
+		status = *game.Status
+	}
+	if err := validateGame(game.HomeTeamId, game.AwayTeamId, status, game.HomeScore, game.AwayScore); err != nil {
+		return nil, err
+	}
+	return g.r.CreateGame(ctx, game)
+}
+
+// DeleteGame implements Game.
+func (g *GameUC) DeleteGame(ctx context.Context, gameID int64) error {
+	return g.r.DeleteGame(ctx, gameID)
+}
+
+// GetGame implements Game.
+func (g *GameUC) GetGame(ctx context.Context, gameID int64) (*gen.Game, error) {
+	return g.r.GetGame(ctx, gameID)
+}
+
+// GetGameList implements Game.
+func (g *GameUC) GetGameList(ctx context.Context, params GameListParams) ([]gen.Game, error) {
+	if params.From != nil && params.To != nil && params.From.After(*params.To) {
+		return nil, apperrors.ErrInvalidGameRange
+	}
+	return g.r.GetGameList(ctx, params)
+}
+
+// UpdateGame implements Game.
+func (g *GameUC) UpdateGame(ctx context.Context, gameID int64, game *gen.GameUpdate) (*gen.Game, error) {
+	if err := validateGame(game.HomeTeamId, game.AwayTeamId, game.Status, game.HomeScore, game.AwayScore); err != nil {
+		return nil, err
+	}
+	return g.r.UpdateGame(ctx, gameID, game)
+}
+
+// validateGame checks the rules the schema cannot express: a team does not play
+// itself, live games may have a score, final games must have one and the others have none.
+func validateGame(homeTeamID, awayTeamID int64, status gen.GameStatus, homeScore, awayScore *int32) error {
+	invalid := func(field, reason string) error {
+		return &apperrors.FieldError{Field: field, Err: fmt.Errorf("%w: %s", apperrors.ErrInvalidField, reason)}
+	}
+	if homeTeamID == awayTeamID {
+		return invalid("awayTeamId", "must differ from homeTeamId")
+	}
+	if (homeScore == nil) != (awayScore == nil) {
+		field := "homeScore"
+		if awayScore == nil {
+			field = "awayScore"
+		}
+		return invalid(field, "both scores must be set or neither")
+	}
+	switch {
+	case status == gen.Final && homeScore == nil:
+		return invalid("homeScore", "required for a final game")
+	case status != gen.Live && status != gen.Final && homeScore != nil:
+		return invalid("homeScore", "not allowed for a "+string(status)+" game")
+	}
+	return nil
+}
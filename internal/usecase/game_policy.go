@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/arsnazarenko/devops-basketball/api/gen"
+)
+
+// GamePolicy wraps a Game use case and authorizes every call by the role of
+// the auth.Principal in the context: every known role can read the schedule,
+// only auth.RoleLeagueAdmin can schedule games and record their results.
+//
+// Calls without a principal are denied with apperrors.ErrForbidden.
+type GamePolicy struct {
+	next Game
+}
+
+func NewGamePolicy(next Game) *GamePolicy {
+	return &GamePolicy{
+		next: next,
+	}
+}
+
+var _ Game = (*GamePolicy)(nil)
+
+// CreateGame implements Game.
+func (g *GamePolicy) CreateGame(ctx context.Context, game *gen.GameCreate) (*gen.Game, error) {
+	if err := authorizeLeagueAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return g.next.CreateGame(ctx, game)
+}
+
+// UpdateGame implements Game.
+func (g *GamePolicy) UpdateGame(ctx context.Context, gameID int64, game *gen.GameUpdate) (*gen.Game, error) {
+	if err := authorizeLeagueAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return g.next.UpdateGame(ctx, gameID, game)
+}
+
+// DeleteGame implements Game.
+func (g *GamePolicy) DeleteGame(ctx context.Context, gameID int64) error {
+	if err := authorizeLeagueAdmin(ctx); err != nil {
+		return err
+	}
+	return g.next.DeleteGame(ctx, gameID)
+}
+
+// GetGame implements Game.
+func (g *GamePolicy) GetGame(ctx context.Context, gameID int64) (*gen.Game, error) {
+	if err := authorizeRead(ctx); err != nil {
+		return nil, err
+	}
+	return g.next.GetGame(ctx, gameID)
+}
+
+// GetGameList implements Game.
+func (g *GamePolicy) GetGameList(ctx context.Context, params GameListParams) ([]gen.Game, error) {
+	if err := authorizeRead(ctx); err != nil {
+		return nil, err
+	}
+	return g.next.GetGameList(ctx, params)
+}
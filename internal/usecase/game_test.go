@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/arsnazarenko/devops-basketball/api/gen"
+	"github.com/arsnazarenko/devops-basketball/internal/apperrors"
+	"github.com/arsnazarenko/devops-basketball/internal/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubGameRepo accepts every change and lists no games.
+type stubGameRepo struct {
+	GameRp
+}
+
+func (stubGameRepo) CreateGame(_ context.Context, game *gen.GameCreate) (*gen.Game, error) {
+	return &gen.Game{HomeTeamId: game.HomeTeamId, AwayTeamId: game.AwayTeamId}, nil
+}
+
+func (stubGameRepo) UpdateGame(_ context.Context, gameID int64, game *gen.GameUpdate) (*gen.Game, error) {
+	return &gen.Game{Id: gameID, Status: game.Status}, nil
+}
+
+func (stubGameRepo) GetGameList(context.Context, GameListParams) ([]gen.Game, error) {
+	return []gen.Game{}, nil
+}
+
+func TestGameValidation(t *testing.T) {
+	uc := NewGameUsecase(stubGameRepo{})
+	score := func(v int32) *int32 { return &v }
+	update := func(home, away int64, status gen.GameStatus, homeScore, awayScore *int32) error {
+		_, err := uc.UpdateGame(context.Background(), 7, &gen.GameUpdate{
+			HomeTeamId: home,
+			AwayTeamId: away,
+			Status:     status,
+			HomeScore:  homeScore,
+			AwayScore:  awayScore,
+		})
+		return err
+	}
+
+	cases := []struct {
+		name  string
+		err   error
+		field string
+	}{
+		{"scheduled without score", update(1, 2, gen.Scheduled, nil, nil), ""},
+		{"live with score", update(1, 2, gen.Live, score(10), score(8)), ""},
+		{"final with score", update(1, 2, gen.Final, score(112), score(108)), ""},
+		{"team plays itself", update(1, 1, gen.Scheduled, nil, nil), "awayTeamId"},
+		{"final without score", update(1, 2, gen.Final, nil, nil), "homeScore"},
+		{"one score only", update(1, 2, gen.Live, score(10), nil), "awayScore"},
+		{"postponed with score", update(1, 2, gen.Postponed, score(0), score(0)), "homeScore"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.field == "" {
+				assert.NoError(t, tc.err)
+				return
+			}
+			var fieldErr *apperrors.FieldError
+			require.ErrorAs(t, tc.err, &fieldErr)
+			assert.Equal(t, tc.field, fieldErr.Field)
+			assert.ErrorIs(t, tc.err, apperrors.ErrInvalidField)
+		})
+	}
+
+	t.Run("games created without status are scheduled", func(t *testing.T) {
+		_, err := uc.CreateGame(context.Background(), &gen.GameCreate{HomeTeamId: 1, AwayTeamId: 2, HomeScore: score(1), AwayScore: score(0)})
+		assert.ErrorIs(t, err, apperrors.ErrInvalidField)
+	})
+
+	t.Run("range ends before it starts", func(t *testing.T) {
+		from := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+		to := from.AddDate(0, -1, 0)
+		_, err := uc.GetGameList(context.Background(), GameListParams{From: &from, To: &to, PageSize: 20, PageNumber: 1})
+		assert.ErrorIs(t, err, apperrors.ErrInvalidGameRange)
+	})
+}
+
+func TestGamePolicy(t *testing.T) {
+	policy := NewGamePolicy(NewGameUsecase(stubGameRepo{}))
+	team := int64(1)
+
+	as := func(role auth.Role, teamID *int64) context.Context {
+		return auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "test", Role: role, TeamID: teamID})
+	}
+	create := func(ctx context.Context) error {
+		_, err := policy.CreateGame(ctx, &gen.GameCreate{HomeTeamId: 1, AwayTeamId: 2})
+		return err
+	}
+	list := func(ctx context.Context) error {
+		_, err := policy.GetGameList(ctx, GameListParams{TeamID: &team, PageSize: 20, PageNumber: 1})
+		return err
+	}
+
+	cases := []struct {
+		name    string
+		err     error
+		allowed bool
+	}{
+		{"anonymous cannot list", list(context.Background()), false},
+		{"scout can list", list(as(auth.RoleScout, nil)), true},
+		{"scout cannot create", create(as(auth.RoleScout, nil)), false},
+		{"manager cannot create", create(as(auth.RoleTeamManager, &team)), false},
+		{"admin creates", create(as(auth.RoleLeagueAdmin, nil)), true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.allowed {
+				assert.NoError(t, tc.err)
+			} else {
+				assert.ErrorIs(t, tc.err, apperrors.ErrForbidden)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/arsnazarenko/devops-basketball/api/gen"
 )
@@ -11,18 +12,95 @@ type (
 	// Player - use case
 	Player interface {
 		CreatePlayer(ctx context.Context, player *gen.PlayerCreate) (*gen.Player, error)
-		UpdatePlayer(ctx context.Context, playerID int64, player *gen.PlayerUpdate) (*gen.Player, error)
-		DeletePlayer(ctx context.Context, playerID int64) error
+		UpdatePlayer(ctx context.Context, playerID, version int64, player *gen.PlayerUpdate) (*gen.Player, error)
+		DeletePlayer(ctx context.Context, playerID, version int64) error
+		RestorePlayer(ctx context.Context, playerID int64) (*gen.Player, error)
 		GetPlayer(ctx context.Context, playerID int64) (*gen.Player, error)
-		GetPlayerList(ctx context.Context, pageSize, pageNumber uint64) ([]gen.Player, error)
+		GetPlayerList(ctx context.Context, params PlayerListParams) (*PlayerPage, error)
+		CountPlayers(ctx context.Context, filter PlayerFilter) (uint64, error)
+		SearchPlayers(ctx context.Context, query string, limit uint64) ([]gen.PlayerSearchResult, error)
+		GetPlayerHistory(ctx context.Context, playerID int64, params PlayerHistoryParams) (*PlayerHistoryPage, error)
+		BatchPlayers(ctx context.Context, ops []PlayerOperation, atomic bool) ([]PlayerOperationResult, error)
+		ImportPlayers(ctx context.Context, rows []PlayerImportRow, dryRun bool) (*PlayerImportResult, error)
+		ExportPlayers(ctx context.Context, params PlayerExportParams) (PlayerCursor, error)
 	}
 
 	// PlayerRp - mongodb
 	PlayerRp interface {
 		CreatePlayer(ctx context.Context, player *gen.PlayerCreate) (*gen.Player, error)
-		UpdatePlayer(ctx context.Context, playerID int64, player *gen.PlayerUpdate) (*gen.Player, error)
-		DeletePlayer(ctx context.Context, playerID int64) error
+		UpdatePlayer(ctx context.Context, playerID, version int64, player *gen.PlayerUpdate) (*gen.Player, error)
+		DeletePlayer(ctx context.Context, playerID, version int64) error
 		GetPlayer(ctx context.Context, playerID int64) (*gen.Player, error)
-		GetPlayerList(ctx context.Context, pageSize, pageNumber uint64) ([]gen.Player, error)
+		GetPlayerList(ctx context.Context, params PlayerListParams) (*PlayerPage, error)
+		CountPlayers(ctx context.Context, filter PlayerFilter) (uint64, error)
+		SearchPlayers(ctx context.Context, query string, limit uint64) ([]gen.PlayerSearchResult, error)
+		GetPlayerHistory(ctx context.Context, playerID int64, params PlayerHistoryParams) (*PlayerHistoryPage, error)
+		RestorePlayer(ctx context.Context, playerID int64) (*gen.Player, error)
+		// BatchPlayers applies ops in order and returns a result for each of them.
+		// When atomic, all ops run in one transaction that the first failure rolls
+		// back; the other ops then fail with apperrors.ErrBatchAborted.
+		// The error is only set when the batch could not run at all.
+		BatchPlayers(ctx context.Context, ops []PlayerOperation, atomic bool) ([]PlayerOperationResult, error)
+		// ImportPlayers creates the players of rows in one transaction, rejecting
+		// the rows of unknown teams. A dry run rolls the transaction back.
+		ImportPlayers(ctx context.Context, rows []PlayerImportRow, dryRun bool) (*PlayerImportResult, error)
+		// ExportPlayers streams the players matching params; the rows are read
+		// while the cursor advances instead of being loaded up front.
+		ExportPlayers(ctx context.Context, params PlayerExportParams) (PlayerCursor, error)
+		// PurgeDeletedPlayers permanently removes the players deleted before the given time
+		PurgeDeletedPlayers(ctx context.Context, deletedBefore time.Time) (int64, error)
+	}
+
+	// IdempotencyRp - postgres
+	IdempotencyRp interface {
+		// ReserveIdempotencyKey claims key of scope for a request with the given hash for ttl.
+		// A key whose request has not completed within lease is claimed again.
+		// It returns the time of the claim when the key was claimed, or the earlier
+		// request made with it.
+		ReserveIdempotencyKey(ctx context.Context, scope, key string, hash []byte, ttl, lease time.Duration) (claimed time.Time, earlier *IdempotentRequest, err error)
+		// CompleteIdempotencyKey stores the response to the request that claimed key at claimed.
+		// It does nothing when the key was claimed again since.
+		CompleteIdempotencyKey(ctx context.Context, scope, key string, claimed time.Time, response *IdempotentResponse) error
+		// ReleaseIdempotencyKey frees key claimed at claimed while its request has not completed.
+		// It does nothing when the key was claimed again since.
+		ReleaseIdempotencyKey(ctx context.Context, scope, key string, claimed time.Time) error
+		DeleteExpiredIdempotencyKeys(ctx context.Context) (int64, error)
+	}
+
+	// Team - use case
+	Team interface {
+		CreateTeam(ctx context.Context, team *gen.TeamCreate) (*gen.Team, error)
+		UpdateTeam(ctx context.Context, teamID int64, team *gen.TeamUpdate) (*gen.Team, error)
+		DeleteTeam(ctx context.Context, teamID int64) error
+		GetTeam(ctx context.Context, teamID int64) (*gen.Team, error)
+		GetTeamList(ctx context.Context, pageSize, pageNumber uint64) ([]gen.Team, error)
+	}
+
+	// TeamRp - postgres
+	TeamRp interface {
+		CreateTeam(ctx context.Context, team *gen.TeamCreate) (*gen.Team, error)
+		UpdateTeam(ctx context.Context, teamID int64, team *gen.TeamUpdate) (*gen.Team, error)
+		DeleteTeam(ctx context.Context, teamID int64) error
+		GetTeam(ctx context.Context, teamID int64) (*gen.Team, error)
+		GetTeamList(ctx context.Context, pageSize, pageNumber uint64) ([]gen.Team, error)
+	}
+
+	// Game - use case
+	Game interface {
+		CreateGame(ctx context.Context, game *gen.GameCreate) (*gen.Game, error)
+		UpdateGame(ctx context.Context, gameID int64, game *gen.GameUpdate) (*gen.Game, error)
+		DeleteGame(ctx context.Context, gameID int64) error
+		GetGame(ctx context.Context, gameID int64) (*gen.Game, error)
+		GetGameList(ctx context.Context, params GameListParams) ([]gen.Game, error)
+	}
+
+	// GameRp - postgres
+	GameRp interface {
+		CreateGame(ctx context.Context, game *gen.GameCreate) (*gen.Game, error)
+		UpdateGame(ctx context.Context, gameID int64, game *gen.GameUpdate) (*gen.Game, error)
+		DeleteGame(ctx context.Context, gameID int64) error
+		GetGame(ctx context.Context, gameID int64) (*gen.Game, error)
+		// GetGameList returns a page of the games matching params, ordered by scheduled time.
+		GetGameList(ctx context.Context, params GameListParams) ([]gen.Game, error)
 	}
 )
@@ -0,0 +1,147 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/arsnazarenko/devops-basketball/api/gen"
+)
+
+// AnyVersion makes a change unconditional, it matches every version of a player.
+const AnyVersion int64 = 0
+
+// PlayerFilter narrows down a player listing. Nil fields are not applied.
+type PlayerFilter struct {
+	TeamID      *int64
+	Role        *string
+	Citizenship *string
+	MinAge      *int
+	MaxAge      *int
+	MinHeight   *int
+	MaxHeight   *int
+	MinWeight   *int
+	MaxWeight   *int
+	// IncludeDeleted also selects deleted players that are not purged yet
+	IncludeDeleted bool
+}
+
+// PlayerSortField is a player field a listing can be sorted by.
+type PlayerSortField string
+
+const (
+	PlayerSortID          PlayerSortField = "id"
+	PlayerSortName        PlayerSortField = "name"
+	PlayerSortSurname     PlayerSortField = "surname"
+	PlayerSortAge         PlayerSortField = "age"
+	PlayerSortHeight      PlayerSortField = "height"
+	PlayerSortWeight      PlayerSortField = "weight"
+	PlayerSortCitizenship PlayerSortField = "citizenship"
+	PlayerSortRole        PlayerSortField = "role"
+	PlayerSortTeamID      PlayerSortField = "teamId"
+)
+
+// PlayerSort is a single sort key of a player listing.
+type PlayerSort struct {
+	Field PlayerSortField
+	Desc  bool
+}
+
+// PlayerListParams describes which page of players to list and how.
+// A non-empty Cursor selects keyset pagination and PageNumber is ignored.
+type PlayerListParams struct {
+	PageSize   uint64
+	PageNumber uint64
+	Cursor     string
+	Filter     PlayerFilter
+	Sort       []PlayerSort
+}
+
+// PlayerPage is a page of a player listing.
+// NextCursor continues the listing after the page and is empty on the last page.
+type PlayerPage struct {
+	Players    []gen.Player
+	NextCursor string
+}
+
+// PlayerExportParams selects the players of an export and their order.
+type PlayerExportParams struct {
+	Filter PlayerFilter
+	Sort   []PlayerSort
+}
+
+// PlayerCursor iterates over players as they are read from the database.
+// It must be closed.
+type PlayerCursor interface {
+	// Next reads the next player into player and reports whether there was one.
+	Next(player *gen.Player) bool
+	// Err returns the error that stopped the iteration, if any.
+	Err() error
+	Close()
+}
+
+// PlayerHistoryParams describes which page of a player history to list.
+// An empty Cursor selects the most recent changes.
+type PlayerHistoryParams struct {
+	PageSize uint64
+	Cursor   string
+}
+
+// PlayerHistoryPage is a page of a player history, newest change first.
+// NextCursor continues the history after the page and is empty on the last page.
+type PlayerHistoryPage struct {
+	Entries    []gen.PlayerAuditEntry
+	NextCursor string
+}
+
+// PlayerOperation is a single change of a player batch. Create is set for
+// creates, Update for updates; ID and Version are set for updates and deletes.
+type PlayerOperation struct {
+	Op      gen.PlayerBatchOperationOp
+	ID      int64
+	Version int64
+	Create  *gen.PlayerCreate
+	Update  *gen.PlayerUpdate
+}
+
+// PlayerOperationResult is the outcome of a PlayerOperation. Player is the
+// created or updated player and is nil for deletes and failures.
+type PlayerOperationResult struct {
+	Player *gen.Player
+	Err    error
+}
+
+// PlayerImportRow is a player to import and the line of the upload it starts at.
+type PlayerImportRow struct {
+	Line   int
+	Player gen.PlayerCreate
+}
+
+// PlayerImportResult is the outcome of an import. Imported counts the players
+// created, Rejected lists the rows that could not be imported.
+type PlayerImportResult struct {
+	Imported int
+	Rejected []gen.PlayerImportRejection
+}
+
+// GameListParams describes which page of the schedule to list. Games of TeamID
+// are those it plays at home or away; From is inclusive and To exclusive.
+type GameListParams struct {
+	TeamID     *int64
+	From       *time.Time
+	To         *time.Time
+	PageSize   uint64
+	PageNumber uint64
+}
+
+// IdempotentResponse is a stored response, replayed to retries with the same idempotency key.
+type IdempotentResponse struct {
+	Status int
+	Header map[string]string
+	Body   []byte
+}
+
+// IdempotentRequest is a request made with an idempotency key.
+// Response is nil while the request is in progress.
+type IdempotentRequest struct {
+	Hash     []byte
+	Response *IdempotentResponse
+}
@@ -24,8 +24,13 @@ func (p *PlayerUC) CreatePlayer(ctx context.Context, player *gen.PlayerCreate) (
 }
 
 // DeletePlayer implements Player.
-func (p *PlayerUC) DeletePlayer(ctx context.Context, playerID int64) error {
-	return p.r.DeletePlayer(ctx, playerID)
+func (p *PlayerUC) DeletePlayer(ctx context.Context, playerID, version int64) error {
+	return p.r.DeletePlayer(ctx, playerID, version)
+}
+
+// RestorePlayer implements Player.
+func (p *PlayerUC) RestorePlayer(ctx context.Context, playerID int64) (*gen.Player, error) {
+	return p.r.RestorePlayer(ctx, playerID)
 }
 
 // GetPlayer implements Player.
@@ -34,11 +39,41 @@ func (p *PlayerUC) GetPlayer(ctx context.Context, playerID int64) (*gen.Player,
 }
 
 // GetPlayerList implements Player.
-func (p *PlayerUC) GetPlayerList(ctx context.Context, count uint64, offset uint64) ([]gen.Player, error) {
-	return p.r.GetPlayerList(ctx, count, offset)
+func (p *PlayerUC) GetPlayerList(ctx context.Context, params PlayerListParams) (*PlayerPage, error) {
+	return p.r.GetPlayerList(ctx, params)
+}
+
+// CountPlayers implements Player.
+func (p *PlayerUC) CountPlayers(ctx context.Context, filter PlayerFilter) (uint64, error) {
+	return p.r.CountPlayers(ctx, filter)
+}
+
+// SearchPlayers implements Player.
+func (p *PlayerUC) SearchPlayers(ctx context.Context, query string, limit uint64) ([]gen.PlayerSearchResult, error) {
+	return p.r.SearchPlayers(ctx, query, limit)
 }
 
 // UpdatePlayer implements Player.
-func (p *PlayerUC) UpdatePlayer(ctx context.Context, playerID int64, player *gen.PlayerUpdate) (*gen.Player, error) {
-	return p.r.UpdatePlayer(ctx, playerID, player)
+func (p *PlayerUC) UpdatePlayer(ctx context.Context, playerID, version int64, player *gen.PlayerUpdate) (*gen.Player, error) {
+	return p.r.UpdatePlayer(ctx, playerID, version, player)
+}
+
+// GetPlayerHistory implements Player.
+func (p *PlayerUC) GetPlayerHistory(ctx context.Context, playerID int64, params PlayerHistoryParams) (*PlayerHistoryPage, error) {
+	return p.r.GetPlayerHistory(ctx, playerID, params)
+}
+
+// BatchPlayers implements Player.
+func (p *PlayerUC) BatchPlayers(ctx context.Context, ops []PlayerOperation, atomic bool) ([]PlayerOperationResult, error) {
+	return p.r.BatchPlayers(ctx, ops, atomic)
+}
+
+// ImportPlayers implements Player.
+func (p *PlayerUC) ImportPlayers(ctx context.Context, rows []PlayerImportRow, dryRun bool) (*PlayerImportResult, error) {
+	return p.r.ImportPlayers(ctx, rows, dryRun)
+}
+
+// ExportPlayers implements Player.
+func (p *PlayerUC) ExportPlayers(ctx context.Context, params PlayerExportParams) (PlayerCursor, error) {
+	return p.r.ExportPlayers(ctx, params)
 }
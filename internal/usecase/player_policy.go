@@ -0,0 +1,306 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/arsnazarenko/devops-basketball/api/gen"
+	"github.com/arsnazarenko/devops-basketball/internal/apperrors"
+	"github.com/arsnazarenko/devops-basketball/internal/auth"
+)
+
+// PlayerPolicy wraps a Player use case and authorizes every call by the role
+// of the auth.Principal in the context:
+//   - auth.RoleScout can only read;
+//   - auth.RoleTeamManager can also modify the players of their own team;
+//   - auth.RoleLeagueAdmin can do anything, including listing and restoring deleted players.
+//
+// Calls without a principal are denied with apperrors.ErrForbidden.
+type PlayerPolicy struct {
+	next Player
+}
+
+func NewPlayerPolicy(next Player) *PlayerPolicy {
+	return &PlayerPolicy{
+		next: next,
+	}
+}
+
+var _ Player = (*PlayerPolicy)(nil)
+
+// principal returns the caller, or apperrors.ErrForbidden when there is none.
+func principal(ctx context.Context) (*auth.Principal, error) {
+	p, ok := auth.PrincipalFrom(ctx)
+	if !ok {
+		return nil, apperrors.ErrForbidden
+	}
+	return p, nil
+}
+
+// canModifyTeam reports whether p may modify the players of the team.
+func canModifyTeam(p *auth.Principal, teamID int64) bool {
+	switch p.Role {
+	case auth.RoleLeagueAdmin:
+		return true
+	case auth.RoleTeamManager:
+		return p.TeamID != nil && *p.TeamID == teamID
+	default:
+		return false
+	}
+}
+
+// authorizeRead allows every known role to read.
+func authorizeRead(ctx context.Context) error {
+	p, err := principal(ctx)
+	if err != nil {
+		return err
+	}
+	if !p.Role.Valid() {
+		return apperrors.ErrForbidden
+	}
+	return nil
+}
+
+// authorizeFilter allows every known role to read, and only league admins to
+// see deleted players.
+func authorizeFilter(ctx context.Context, filter PlayerFilter) error {
+	p, err := principal(ctx)
+	if err != nil {
+		return err
+	}
+	if !p.Role.Valid() || filter.IncludeDeleted && p.Role != auth.RoleLeagueAdmin {
+		return apperrors.ErrForbidden
+	}
+	return nil
+}
+
+// authorizeLeagueAdmin allows only league admins.
+func authorizeLeagueAdmin(ctx context.Context) error {
+	p, err := principal(ctx)
+	if err != nil {
+		return err
+	}
+	if p.Role != auth.RoleLeagueAdmin {
+		return apperrors.ErrForbidden
+	}
+	return nil
+}
+
+// authorizePlayerChange checks that the caller may modify existing players.
+// Team managers get a context scoped to their team: the repository checks the
+// team of the player in the transaction that changes it, see TeamScope.
+func authorizePlayerChange(ctx context.Context) (context.Context, *auth.Principal, error) {
+	caller, err := principal(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	switch caller.Role {
+	case auth.RoleLeagueAdmin:
+		return ctx, caller, nil
+	case auth.RoleTeamManager:
+		if caller.TeamID == nil {
+			return nil, nil, apperrors.ErrForbidden
+		}
+		return WithTeamScope(ctx, *caller.TeamID), caller, nil
+	default:
+		return nil, nil, apperrors.ErrForbidden
+	}
+}
+
+type teamScopeKey struct{}
+
+// WithTeamScope returns a copy of ctx that allows changing only the players
+// of the given team. Changes of other players fail with apperrors.ErrForbidden.
+func WithTeamScope(ctx context.Context, teamID int64) context.Context {
+	return context.WithValue(ctx, teamScopeKey{}, teamID)
+}
+
+// TeamScope returns the only team whose players may be changed, if the
+// changes are restricted to one.
+func TeamScope(ctx context.Context) (int64, bool) {
+	teamID, ok := ctx.Value(teamScopeKey{}).(int64)
+	return teamID, ok
+}
+
+// CreatePlayer implements Player.
+func (p *PlayerPolicy) CreatePlayer(ctx context.Context, player *gen.PlayerCreate) (*gen.Player, error) {
+	caller, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !canModifyTeam(caller, player.TeamId) {
+		return nil, apperrors.ErrForbidden
+	}
+	return p.next.CreatePlayer(ctx, player)
+}
+
+// UpdatePlayer implements Player. Team managers cannot move players to other teams.
+func (p *PlayerPolicy) UpdatePlayer(ctx context.Context, playerID, version int64, player *gen.PlayerUpdate) (*gen.Player, error) {
+	ctx, caller, err := authorizePlayerChange(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if player.TeamId != nil && !canModifyTeam(caller, *player.TeamId) {
+		return nil, apperrors.ErrForbidden
+	}
+	return p.next.UpdatePlayer(ctx, playerID, version, player)
+}
+
+// DeletePlayer implements Player.
+func (p *PlayerPolicy) DeletePlayer(ctx context.Context, playerID, version int64) error {
+	ctx, _, err := authorizePlayerChange(ctx)
+	if err != nil {
+		return err
+	}
+	return p.next.DeletePlayer(ctx, playerID, version)
+}
+
+// RestorePlayer implements Player. Only league admins can restore players.
+func (p *PlayerPolicy) RestorePlayer(ctx context.Context, playerID int64) (*gen.Player, error) {
+	caller, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if caller.Role != auth.RoleLeagueAdmin {
+		return nil, apperrors.ErrForbidden
+	}
+	return p.next.RestorePlayer(ctx, playerID)
+}
+
+// GetPlayer implements Player.
+func (p *PlayerPolicy) GetPlayer(ctx context.Context, playerID int64) (*gen.Player, error) {
+	if err := authorizeRead(ctx); err != nil {
+		return nil, err
+	}
+	return p.next.GetPlayer(ctx, playerID)
+}
+
+// GetPlayerList implements Player.
+func (p *PlayerPolicy) GetPlayerList(ctx context.Context, params PlayerListParams) (*PlayerPage, error) {
+	if err := authorizeFilter(ctx, params.Filter); err != nil {
+		return nil, err
+	}
+	return p.next.GetPlayerList(ctx, params)
+}
+
+// CountPlayers implements Player.
+func (p *PlayerPolicy) CountPlayers(ctx context.Context, filter PlayerFilter) (uint64, error) {
+	if err := authorizeFilter(ctx, filter); err != nil {
+		return 0, err
+	}
+	return p.next.CountPlayers(ctx, filter)
+}
+
+// SearchPlayers implements Player.
+func (p *PlayerPolicy) SearchPlayers(ctx context.Context, query string, limit uint64) ([]gen.PlayerSearchResult, error) {
+	if err := authorizeRead(ctx); err != nil {
+		return nil, err
+	}
+	return p.next.SearchPlayers(ctx, query, limit)
+}
+
+// GetPlayerHistory implements Player.
+func (p *PlayerPolicy) GetPlayerHistory(ctx context.Context, playerID int64, params PlayerHistoryParams) (*PlayerHistoryPage, error) {
+	if err := authorizeRead(ctx); err != nil {
+		return nil, err
+	}
+	return p.next.GetPlayerHistory(ctx, playerID, params)
+}
+
+// BatchPlayers implements Player. Every operation is authorized like the
+// single call it stands for. A denied operation fails on its own, or aborts
+// the whole batch when it is atomic.
+func (p *PlayerPolicy) BatchPlayers(ctx context.Context, ops []PlayerOperation, atomic bool) ([]PlayerOperationResult, error) {
+	caller, ok := auth.PrincipalFrom(ctx)
+	if ok && caller.Role == auth.RoleTeamManager && caller.TeamID != nil {
+		ctx = WithTeamScope(ctx, *caller.TeamID)
+	}
+	results := make([]PlayerOperationResult, len(ops))
+	allowed := make([]PlayerOperation, 0, len(ops))
+	indexes := make([]int, 0, len(ops))
+	for i, op := range ops {
+		if err := authorizeOperation(caller, op); err != nil {
+			results[i].Err = err
+			continue
+		}
+		allowed = append(allowed, op)
+		indexes = append(indexes, i)
+	}
+	if len(allowed) == len(ops) {
+		return p.next.BatchPlayers(ctx, ops, atomic)
+	}
+	if atomic {
+		for _, i := range indexes {
+			results[i].Err = apperrors.ErrBatchAborted
+		}
+		return results, nil
+	}
+	if len(allowed) == 0 {
+		return results, nil
+	}
+	done, err := p.next.BatchPlayers(ctx, allowed, false)
+	if err != nil {
+		return nil, err
+	}
+	for j, i := range indexes {
+		results[i] = done[j]
+	}
+	return results, nil
+}
+
+// authorizeOperation checks the teams named by a single batch operation of
+// caller, who is nil for anonymous calls. The teams of the players it changes
+// are checked by the repository.
+func authorizeOperation(caller *auth.Principal, op PlayerOperation) error {
+	if caller == nil || caller.Role != auth.RoleLeagueAdmin && (caller.Role != auth.RoleTeamManager || caller.TeamID == nil) {
+		return apperrors.ErrForbidden
+	}
+	switch op.Op {
+	case gen.PlayerBatchOperationOpCreate:
+		if op.Create != nil && !canModifyTeam(caller, op.Create.TeamId) {
+			return apperrors.ErrForbidden
+		}
+	case gen.PlayerBatchOperationOpUpdate:
+		if op.Update != nil && op.Update.TeamId != nil && !canModifyTeam(caller, *op.Update.TeamId) {
+			return apperrors.ErrForbidden
+		}
+	}
+	return nil
+}
+
+// ImportPlayers implements Player. Rows for teams the caller may not modify
+// are rejected, the others are imported.
+func (p *PlayerPolicy) ImportPlayers(ctx context.Context, rows []PlayerImportRow, dryRun bool) (*PlayerImportResult, error) {
+	caller, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if caller.Role != auth.RoleLeagueAdmin && caller.Role != auth.RoleTeamManager {
+		return nil, apperrors.ErrForbidden
+	}
+	var denied []gen.PlayerImportRejection
+	allowed := make([]PlayerImportRow, 0, len(rows))
+	for _, row := range rows {
+		if !canModifyTeam(caller, row.Player.TeamId) {
+			denied = append(denied, gen.PlayerImportRejection{
+				Line:   row.Line,
+				Errors: []gen.FieldError{{Field: "teamId", Message: apperrors.ErrForbidden.Error()}},
+			})
+			continue
+		}
+		allowed = append(allowed, row)
+	}
+	result, err := p.next.ImportPlayers(ctx, allowed, dryRun)
+	if err != nil {
+		return nil, err
+	}
+	result.Rejected = append(result.Rejected, denied...)
+	return result, nil
+}
+
+// ExportPlayers implements Player.
+func (p *PlayerPolicy) ExportPlayers(ctx context.Context, params PlayerExportParams) (PlayerCursor, error) {
+	if err := authorizeFilter(ctx, params.Filter); err != nil {
+		return nil, err
+	}
+	return p.next.ExportPlayers(ctx, params)
+}
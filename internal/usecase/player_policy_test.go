@@ -0,0 +1,211 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/arsnazarenko/devops-basketball/api/gen"
+	"github.com/arsnazarenko/devops-basketball/internal/apperrors"
+	"github.com/arsnazarenko/devops-basketball/internal/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubPlayer serves players of team 1 and accepts every change the TeamScope
+// allows, like the repository does.
+type stubPlayer struct {
+	Player
+}
+
+func checkTeamScope(ctx context.Context) error {
+	if teamID, ok := TeamScope(ctx); ok && teamID != 1 {
+		return apperrors.ErrForbidden
+	}
+	return nil
+}
+
+func (stubPlayer) GetPlayer(_ context.Context, playerID int64) (*gen.Player, error) {
+	return &gen.Player{Id: playerID, TeamId: 1}, nil
+}
+
+func (stubPlayer) CreatePlayer(_ context.Context, player *gen.PlayerCreate) (*gen.Player, error) {
+	return &gen.Player{TeamId: player.TeamId}, nil
+}
+
+func (s stubPlayer) UpdatePlayer(ctx context.Context, playerID, _ int64, _ *gen.PlayerUpdate) (*gen.Player, error) {
+	if err := checkTeamScope(ctx); err != nil {
+		return nil, err
+	}
+	return s.GetPlayer(ctx, playerID)
+}
+
+func (stubPlayer) DeletePlayer(ctx context.Context, _, _ int64) error {
+	return checkTeamScope(ctx)
+}
+
+func (s stubPlayer) RestorePlayer(ctx context.Context, playerID int64) (*gen.Player, error) {
+	return s.GetPlayer(ctx, playerID)
+}
+
+func (stubPlayer) BatchPlayers(ctx context.Context, ops []PlayerOperation, _ bool) ([]PlayerOperationResult, error) {
+	results := make([]PlayerOperationResult, len(ops))
+	for i, op := range ops {
+		if op.Op != gen.PlayerBatchOperationOpCreate {
+			if err := checkTeamScope(ctx); err != nil {
+				results[i].Err = err
+				continue
+			}
+		}
+		results[i].Player = &gen.Player{TeamId: 1}
+	}
+	return results, nil
+}
+
+func (stubPlayer) ImportPlayers(_ context.Context, rows []PlayerImportRow, _ bool) (*PlayerImportResult, error) {
+	return &PlayerImportResult{Imported: len(rows)}, nil
+}
+
+func (stubPlayer) GetPlayerList(context.Context, PlayerListParams) (*PlayerPage, error) {
+	return &PlayerPage{}, nil
+}
+
+func (stubPlayer) ExportPlayers(context.Context, PlayerExportParams) (PlayerCursor, error) {
+	return nil, nil
+}
+
+func TestPlayerPolicy(t *testing.T) {
+	policy := NewPlayerPolicy(stubPlayer{})
+	ownTeam, otherTeam := int64(1), int64(2)
+
+	as := func(role auth.Role, teamID *int64) context.Context {
+		return auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "test", Role: role, TeamID: teamID})
+	}
+	create := func(ctx context.Context, teamID int64) error {
+		_, err := policy.CreatePlayer(ctx, &gen.PlayerCreate{TeamId: teamID})
+		return err
+	}
+	update := func(ctx context.Context, teamID *int64) error {
+		_, err := policy.UpdatePlayer(ctx, 10, AnyVersion, &gen.PlayerUpdate{TeamId: teamID})
+		return err
+	}
+	read := func(ctx context.Context) error {
+		_, err := policy.GetPlayer(ctx, 10)
+		return err
+	}
+	restore := func(ctx context.Context) error {
+		_, err := policy.RestorePlayer(ctx, 10)
+		return err
+	}
+	listDeleted := func(ctx context.Context) error {
+		_, err := policy.GetPlayerList(ctx, PlayerListParams{Filter: PlayerFilter{IncludeDeleted: true}})
+		return err
+	}
+	exportDeleted := func(ctx context.Context) error {
+		_, err := policy.ExportPlayers(ctx, PlayerExportParams{Filter: PlayerFilter{IncludeDeleted: true}})
+		return err
+	}
+
+	cases := []struct {
+		name    string
+		err     error
+		allowed bool
+	}{
+		{"anonymous cannot read", read(context.Background()), false},
+		{"scout can read", read(as(auth.RoleScout, nil)), true},
+		{"scout cannot create", create(as(auth.RoleScout, nil), ownTeam), false},
+		{"scout cannot delete", policy.DeletePlayer(as(auth.RoleScout, nil), 10, AnyVersion), false},
+		{"manager creates in own team", create(as(auth.RoleTeamManager, &ownTeam), ownTeam), true},
+		{"manager cannot create in other team", create(as(auth.RoleTeamManager, &ownTeam), otherTeam), false},
+		{"manager updates own player", update(as(auth.RoleTeamManager, &ownTeam), nil), true},
+		{"manager cannot move player away", update(as(auth.RoleTeamManager, &ownTeam), &otherTeam), false},
+		{"manager cannot update other team's player", update(as(auth.RoleTeamManager, &otherTeam), nil), false},
+		{"manager deletes own player", policy.DeletePlayer(as(auth.RoleTeamManager, &ownTeam), 10, AnyVersion), true},
+		{"manager cannot delete other team's player", policy.DeletePlayer(as(auth.RoleTeamManager, &otherTeam), 10, AnyVersion), false},
+		{"admin creates anywhere", create(as(auth.RoleLeagueAdmin, nil), otherTeam), true},
+		{"admin moves players", update(as(auth.RoleLeagueAdmin, nil), &otherTeam), true},
+		{"manager cannot restore own player", restore(as(auth.RoleTeamManager, &ownTeam)), false},
+		{"admin restores players", restore(as(auth.RoleLeagueAdmin, nil)), true},
+		{"scout cannot list deleted players", listDeleted(as(auth.RoleScout, nil)), false},
+		{"admin lists deleted players", listDeleted(as(auth.RoleLeagueAdmin, nil)), true},
+		{"scout cannot export deleted players", exportDeleted(as(auth.RoleScout, nil)), false},
+		{"admin exports deleted players", exportDeleted(as(auth.RoleLeagueAdmin, nil)), true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.allowed {
+				assert.NoError(t, tc.err)
+			} else {
+				assert.ErrorIs(t, tc.err, apperrors.ErrForbidden)
+			}
+		})
+	}
+}
+
+func TestPlayerPolicyBatch(t *testing.T) {
+	policy := NewPlayerPolicy(stubPlayer{})
+	ownTeam, otherTeam := int64(1), int64(2)
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "test", Role: auth.RoleTeamManager, TeamID: &ownTeam})
+	ops := []PlayerOperation{
+		{Op: gen.PlayerBatchOperationOpCreate, Create: &gen.PlayerCreate{TeamId: ownTeam}},
+		{Op: gen.PlayerBatchOperationOpCreate, Create: &gen.PlayerCreate{TeamId: otherTeam}},
+		{Op: gen.PlayerBatchOperationOpDelete, ID: 10, Version: 1},
+	}
+
+	t.Run("best effort runs the allowed operations", func(t *testing.T) {
+		results, err := policy.BatchPlayers(ctx, ops, false)
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+		assert.NoError(t, results[0].Err)
+		assert.ErrorIs(t, results[1].Err, apperrors.ErrForbidden)
+		assert.NoError(t, results[2].Err)
+	})
+
+	t.Run("atomic aborts on a denied operation", func(t *testing.T) {
+		results, err := policy.BatchPlayers(ctx, ops, true)
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+		assert.ErrorIs(t, results[0].Err, apperrors.ErrBatchAborted)
+		assert.ErrorIs(t, results[1].Err, apperrors.ErrForbidden)
+		assert.ErrorIs(t, results[2].Err, apperrors.ErrBatchAborted)
+	})
+
+	t.Run("players of other teams are checked in the repository", func(t *testing.T) {
+		ctx := auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "test", Role: auth.RoleTeamManager, TeamID: &otherTeam})
+		results, err := policy.BatchPlayers(ctx, ops[2:], false)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.ErrorIs(t, results[0].Err, apperrors.ErrForbidden)
+	})
+
+	t.Run("anonymous operations are denied", func(t *testing.T) {
+		results, err := policy.BatchPlayers(context.Background(), ops, false)
+		require.NoError(t, err)
+		for _, r := range results {
+			assert.ErrorIs(t, r.Err, apperrors.ErrForbidden)
+		}
+	})
+}
+
+func TestPlayerPolicyImport(t *testing.T) {
+	policy := NewPlayerPolicy(stubPlayer{})
+	ownTeam, otherTeam := int64(1), int64(2)
+	rows := []PlayerImportRow{
+		{Line: 2, Player: gen.PlayerCreate{TeamId: ownTeam}},
+		{Line: 3, Player: gen.PlayerCreate{TeamId: otherTeam}},
+	}
+
+	t.Run("manager imports into own team", func(t *testing.T) {
+		ctx := auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "test", Role: auth.RoleTeamManager, TeamID: &ownTeam})
+		result, err := policy.ImportPlayers(ctx, rows, false)
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Imported)
+		require.Len(t, result.Rejected, 1)
+		assert.Equal(t, 3, result.Rejected[0].Line)
+	})
+
+	t.Run("scout cannot import", func(t *testing.T) {
+		ctx := auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "test", Role: auth.RoleScout})
+		_, err := policy.ImportPlayers(ctx, rows, false)
+		assert.ErrorIs(t, err, apperrors.ErrForbidden)
+	})
+}
@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// PlayerPurge removes deleted players once their retention has passed.
+type PlayerPurge struct {
+	r         PlayerRp
+	l         *slog.Logger
+	retention time.Duration
+	now       func() time.Time
+}
+
+func NewPlayerPurge(repo PlayerRp, l *slog.Logger, retention time.Duration) *PlayerPurge {
+	return &PlayerPurge{
+		r:         repo,
+		l:         l,
+		retention: retention,
+		now:       time.Now,
+	}
+}
+
+// Purge removes the players deleted more than retention ago. Failures are
+// logged, it is meant to be run periodically.
+func (p *PlayerPurge) Purge(ctx context.Context) {
+	purged, err := p.r.PurgeDeletedPlayers(ctx, p.now().Add(-p.retention))
+	if err != nil {
+		if ctx.Err() == nil {
+			p.l.Error("purge deleted players", slog.Any("error", err))
+		}
+		return
+	}
+	if purged > 0 {
+		p.l.Info("purged deleted players", slog.Int64("count", purged))
+	}
+}
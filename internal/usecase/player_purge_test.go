@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// stubPurgeRp records the cutoff of the last purge.
+type stubPurgeRp struct {
+	PlayerRp
+	deletedBefore time.Time
+	err           error
+}
+
+func (s *stubPurgeRp) PurgeDeletedPlayers(_ context.Context, deletedBefore time.Time) (int64, error) {
+	s.deletedBefore = deletedBefore
+	return 2, s.err
+}
+
+func TestPlayerPurge(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	repo := &stubPurgeRp{}
+	purge := NewPlayerPurge(repo, slog.New(slog.NewTextHandler(io.Discard, nil)), 72*time.Hour)
+	purge.now = func() time.Time { return now }
+
+	purge.Purge(context.Background())
+	assert.Equal(t, now.Add(-72*time.Hour), repo.deletedBefore)
+
+	t.Run("failures are retried on the next run", func(t *testing.T) {
+		repo.err = errors.New("connection refused")
+		purge.Purge(context.Background())
+		repo.err = nil
+		now = now.Add(time.Hour)
+		purge.Purge(context.Background())
+		assert.Equal(t, now.Add(-72*time.Hour), repo.deletedBefore)
+	})
+}
@@ -0,0 +1,159 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/arsnazarenko/devops-basketball/api/gen"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/arsnazarenko/devops-basketball/internal/usecase"
+
+// PlayerTracing wraps a Player use case with a span per method call.
+type PlayerTracing struct {
+	next   Player
+	tracer trace.Tracer
+}
+
+func NewPlayerTracing(next Player) *PlayerTracing {
+	return &PlayerTracing{
+		next:   next,
+		tracer: otel.Tracer(tracerName),
+	}
+}
+
+var _ Player = (*PlayerTracing)(nil)
+
+func (p *PlayerTracing) start(ctx context.Context, method string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return p.tracer.Start(ctx, "usecase.Player."+method, trace.WithAttributes(attrs...))
+}
+
+// end records err on the span and ends it.
+func end(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// CreatePlayer implements Player.
+func (p *PlayerTracing) CreatePlayer(ctx context.Context, player *gen.PlayerCreate) (created *gen.Player, err error) {
+	ctx, span := p.start(ctx, "CreatePlayer", attribute.Int64("team.id", player.TeamId))
+	defer func() { end(span, err) }()
+	return p.next.CreatePlayer(ctx, player)
+}
+
+// UpdatePlayer implements Player.
+func (p *PlayerTracing) UpdatePlayer(ctx context.Context, playerID, version int64, player *gen.PlayerUpdate) (updated *gen.Player, err error) {
+	ctx, span := p.start(ctx, "UpdatePlayer", attribute.Int64("player.id", playerID), attribute.Int64("player.version", version))
+	defer func() { end(span, err) }()
+	return p.next.UpdatePlayer(ctx, playerID, version, player)
+}
+
+// DeletePlayer implements Player.
+func (p *PlayerTracing) DeletePlayer(ctx context.Context, playerID, version int64) (err error) {
+	ctx, span := p.start(ctx, "DeletePlayer", attribute.Int64("player.id", playerID), attribute.Int64("player.version", version))
+	defer func() { end(span, err) }()
+	return p.next.DeletePlayer(ctx, playerID, version)
+}
+
+// RestorePlayer implements Player.
+func (p *PlayerTracing) RestorePlayer(ctx context.Context, playerID int64) (player *gen.Player, err error) {
+	ctx, span := p.start(ctx, "RestorePlayer", attribute.Int64("player.id", playerID))
+	defer func() { end(span, err) }()
+	return p.next.RestorePlayer(ctx, playerID)
+}
+
+// GetPlayer implements Player.
+func (p *PlayerTracing) GetPlayer(ctx context.Context, playerID int64) (player *gen.Player, err error) {
+	ctx, span := p.start(ctx, "GetPlayer", attribute.Int64("player.id", playerID))
+	defer func() { end(span, err) }()
+	return p.next.GetPlayer(ctx, playerID)
+}
+
+// GetPlayerList implements Player.
+func (p *PlayerTracing) GetPlayerList(ctx context.Context, params PlayerListParams) (page *PlayerPage, err error) {
+	ctx, span := p.start(ctx, "GetPlayerList",
+		attribute.Int64("page.size", int64(params.PageSize)),
+		attribute.Bool("page.cursor", params.Cursor != ""),
+	)
+	defer func() {
+		if page != nil {
+			span.SetAttributes(attribute.Int("page.players", len(page.Players)))
+		}
+		end(span, err)
+	}()
+	return p.next.GetPlayerList(ctx, params)
+}
+
+// CountPlayers implements Player.
+func (p *PlayerTracing) CountPlayers(ctx context.Context, filter PlayerFilter) (count uint64, err error) {
+	ctx, span := p.start(ctx, "CountPlayers")
+	defer func() { end(span, err) }()
+	return p.next.CountPlayers(ctx, filter)
+}
+
+// SearchPlayers implements Player.
+func (p *PlayerTracing) SearchPlayers(ctx context.Context, query string, limit uint64) (results []gen.PlayerSearchResult, err error) {
+	ctx, span := p.start(ctx, "SearchPlayers", attribute.Int64("search.limit", int64(limit)))
+	defer func() {
+		span.SetAttributes(attribute.Int("search.results", len(results)))
+		end(span, err)
+	}()
+	return p.next.SearchPlayers(ctx, query, limit)
+}
+
+// GetPlayerHistory implements Player.
+func (p *PlayerTracing) GetPlayerHistory(ctx context.Context, playerID int64, params PlayerHistoryParams) (page *PlayerHistoryPage, err error) {
+	ctx, span := p.start(ctx, "GetPlayerHistory",
+		attribute.Int64("player.id", playerID),
+		attribute.Int64("page.size", int64(params.PageSize)),
+		attribute.Bool("page.cursor", params.Cursor != ""),
+	)
+	defer func() {
+		if page != nil {
+			span.SetAttributes(attribute.Int("page.entries", len(page.Entries)))
+		}
+		end(span, err)
+	}()
+	return p.next.GetPlayerHistory(ctx, playerID, params)
+}
+
+// BatchPlayers implements Player.
+func (p *PlayerTracing) BatchPlayers(ctx context.Context, ops []PlayerOperation, atomic bool) (results []PlayerOperationResult, err error) {
+	ctx, span := p.start(ctx, "BatchPlayers", attribute.Int("batch.size", len(ops)), attribute.Bool("batch.atomic", atomic))
+	defer func() {
+		failed := 0
+		for _, r := range results {
+			if r.Err != nil {
+				failed++
+			}
+		}
+		span.SetAttributes(attribute.Int("batch.failed", failed))
+		end(span, err)
+	}()
+	return p.next.BatchPlayers(ctx, ops, atomic)
+}
+
+// ImportPlayers implements Player.
+func (p *PlayerTracing) ImportPlayers(ctx context.Context, rows []PlayerImportRow, dryRun bool) (result *PlayerImportResult, err error) {
+	ctx, span := p.start(ctx, "ImportPlayers", attribute.Int("import.rows", len(rows)), attribute.Bool("import.dry_run", dryRun))
+	defer func() {
+		if result != nil {
+			span.SetAttributes(attribute.Int("import.imported", result.Imported), attribute.Int("import.rejected", len(result.Rejected)))
+		}
+		end(span, err)
+	}()
+	return p.next.ImportPlayers(ctx, rows, dryRun)
+}
+
+// ExportPlayers implements Player. The span covers the query, not the iteration of the cursor.
+func (p *PlayerTracing) ExportPlayers(ctx context.Context, params PlayerExportParams) (cursor PlayerCursor, err error) {
+	ctx, span := p.start(ctx, "ExportPlayers", attribute.Bool("filter.include_deleted", params.Filter.IncludeDeleted))
+	defer func() { end(span, err) }()
+	return p.next.ExportPlayers(ctx, params)
+}
@@ -0,0 +1,61 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/arsnazarenko/devops-basketball/internal/apperrors"
+	"github.com/arsnazarenko/devops-basketball/internal/auth"
+	"github.com/arsnazarenko/devops-basketball/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+var _ auth.KeyStore = (*APIKeyRepo)(nil)
+
+type APIKeyRepo struct {
+	pg *postgres.Postgres
+}
+
+func NewAPIKeyRepo(pg *postgres.Postgres) *APIKeyRepo {
+	return &APIKeyRepo{
+		pg: pg,
+	}
+}
+
+// CreateAPIKey stores the hash of a new API key with its role and returns its id.
+func (a *APIKeyRepo) CreateAPIKey(ctx context.Context, name string, hash []byte, role auth.Role, teamID *int64) (int64, error) {
+	query := "INSERT INTO api_keys (name, key_hash, role, team_id) VALUES ($1, $2, $3, $4) RETURNING id"
+	var id int64
+	if err := a.pg.Pool.QueryRow(ctx, query, name, hash, role, teamID).Scan(&id); err != nil {
+		return 0, fmt.Errorf("repo.CreateAPIKey: error: %w", constraintError(err))
+	}
+	return id, nil
+}
+
+// RevokeAPIKey disables the API key with the given id.
+func (a *APIKeyRepo) RevokeAPIKey(ctx context.Context, id int64) error {
+	query := "UPDATE api_keys SET revoked_at = now() WHERE id = $1 AND revoked_at IS NULL"
+	res, err := a.pg.Pool.Exec(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("repo.RevokeAPIKey: error: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		return apperrors.ErrAPIKeyNotFound
+	}
+	return nil
+}
+
+// FindAPIKey implements auth.KeyStore.
+func (a *APIKeyRepo) FindAPIKey(ctx context.Context, hash []byte) (*auth.Principal, error) {
+	query := "SELECT name, role, team_id FROM api_keys WHERE key_hash = $1 AND revoked_at IS NULL"
+	principal := &auth.Principal{Method: auth.MethodAPIKey}
+	err := a.pg.Pool.QueryRow(ctx, query, hash).Scan(&principal.Subject, &principal.Role, &principal.TeamID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, apperrors.ErrAPIKeyNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("repo.FindAPIKey: error: %w", err)
+	}
+	return principal, nil
+}
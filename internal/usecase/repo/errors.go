@@ -0,0 +1,110 @@
+package repo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/arsnazarenko/devops-basketball/internal/apperrors"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	pgNotNullViolation    = "23502"
+	pgForeignKeyViolation = "23503"
+	pgUniqueViolation     = "23505"
+	pgCheckViolation      = "23514"
+	pgExclusionViolation  = "23P01"
+	// pgIntegrityConstraintViolationClass is the SQLSTATE class of all constraint violations
+	pgIntegrityConstraintViolationClass = "23"
+
+	playersTeamIDFkey   = "players_team_id_fkey"
+	gamesHomeTeamIDFkey = "games_home_team_id_fkey"
+	gamesAwayTeamIDFkey = "games_away_team_id_fkey"
+)
+
+// constraintFields maps constraint names to the API fields they guard.
+var constraintFields = map[string]string{
+	"players_name_check":        "name",
+	"players_surname_check":     "surname",
+	"players_age_check":         "age",
+	"players_height_check":      "height",
+	"players_weight_check":      "weight",
+	"players_citizenship_check": "citizenship",
+	"players_role_check":        "role",
+	"players_team_id_check":     "teamId",
+	playersTeamIDFkey:           "teamId",
+	"teams_name_check":          "name",
+	"teams_city_check":          "city",
+	"games_venue_check":         "venue",
+	"games_status_check":        "status",
+	"games_home_score_check":    "homeScore",
+	"games_away_score_check":    "awayScore",
+	"games_teams_check":         "awayTeamId",
+	"games_score_check":         "homeScore",
+	gamesHomeTeamIDFkey:         "homeTeamId",
+	gamesAwayTeamIDFkey:         "awayTeamId",
+}
+
+// isForeignKeyViolation reports whether err is a violation of the given foreign key constraint.
+func isForeignKeyViolation(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == pgForeignKeyViolation && pgErr.ConstraintName == constraint
+}
+
+// missingTeamError returns an *apperrors.FieldError wrapping
+// apperrors.ErrTeamNotFound when err violates one of the given team foreign
+// keys, and nil otherwise.
+func missingTeamError(err error, constraints ...string) error {
+	for _, constraint := range constraints {
+		if isForeignKeyViolation(err, constraint) {
+			return &apperrors.FieldError{
+				Field:      constraintFields[constraint],
+				Constraint: constraint,
+				Err:        apperrors.ErrTeamNotFound,
+			}
+		}
+	}
+	return nil
+}
+
+// constraintError translates constraint violations into domain errors and
+// returns other errors unchanged. Violations of a known field become an
+// *apperrors.FieldError, the rest are marked with apperrors.ErrConstraintViolation.
+func constraintError(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || !strings.HasPrefix(pgErr.Code, pgIntegrityConstraintViolationClass) {
+		return err
+	}
+
+	var kind error
+	switch pgErr.Code {
+	case pgNotNullViolation, pgCheckViolation:
+		kind = apperrors.ErrInvalidField
+	case pgUniqueViolation, pgForeignKeyViolation, pgExclusionViolation:
+		kind = apperrors.ErrConflictingField
+	}
+	field, ok := constraintFields[pgErr.ConstraintName]
+	if !ok && pgErr.Code == pgNotNullViolation {
+		field, ok = toFieldName(pgErr.ColumnName), pgErr.ColumnName != ""
+	}
+	if kind == nil || !ok {
+		return fmt.Errorf("%w: %w", apperrors.ErrConstraintViolation, err)
+	}
+	return fmt.Errorf("%w: %w", &apperrors.FieldError{
+		Field:      field,
+		Constraint: pgErr.ConstraintName,
+		Err:        kind,
+	}, err)
+}
+
+// toFieldName converts a snake_case column name into the camelCase API field name.
+func toFieldName(column string) string {
+	parts := strings.Split(column, "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
@@ -0,0 +1,69 @@
+package repo
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/arsnazarenko/devops-basketball/internal/apperrors"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConstraintError(t *testing.T) {
+	t.Run("check violation of a known field", func(t *testing.T) {
+		err := constraintError(&pgconn.PgError{Code: pgCheckViolation, ConstraintName: "players_age_check"})
+
+		var fieldErr *apperrors.FieldError
+		require.ErrorAs(t, err, &fieldErr)
+		assert.Equal(t, "age", fieldErr.Field)
+		assert.Equal(t, "players_age_check", fieldErr.Constraint)
+		assert.ErrorIs(t, err, apperrors.ErrInvalidField)
+	})
+
+	t.Run("unique violation", func(t *testing.T) {
+		err := constraintError(&pgconn.PgError{Code: pgUniqueViolation, ConstraintName: playersTeamIDFkey})
+
+		assert.ErrorIs(t, err, apperrors.ErrConflictingField)
+	})
+
+	t.Run("not null violation falls back to the column", func(t *testing.T) {
+		err := constraintError(&pgconn.PgError{Code: pgNotNullViolation, ColumnName: "team_id"})
+
+		var fieldErr *apperrors.FieldError
+		require.ErrorAs(t, err, &fieldErr)
+		assert.Equal(t, "teamId", fieldErr.Field)
+	})
+
+	t.Run("unknown constraint", func(t *testing.T) {
+		err := constraintError(&pgconn.PgError{Code: pgCheckViolation, ConstraintName: "players_other_check"})
+
+		assert.ErrorIs(t, err, apperrors.ErrConstraintViolation)
+		assert.NotErrorIs(t, err, apperrors.ErrInvalidField)
+	})
+
+	t.Run("other errors are unchanged", func(t *testing.T) {
+		orig := errors.New("connection refused")
+
+		assert.Equal(t, orig, constraintError(orig))
+	})
+}
+
+func TestMissingTeamError(t *testing.T) {
+	t.Run("foreign key violation names the field", func(t *testing.T) {
+		err := missingTeamError(&pgconn.PgError{Code: pgForeignKeyViolation, ConstraintName: gamesAwayTeamIDFkey},
+			gamesHomeTeamIDFkey, gamesAwayTeamIDFkey)
+
+		var fieldErr *apperrors.FieldError
+		require.ErrorAs(t, err, &fieldErr)
+		assert.Equal(t, "awayTeamId", fieldErr.Field)
+		assert.ErrorIs(t, err, apperrors.ErrTeamNotFound)
+	})
+
+	t.Run("other errors", func(t *testing.T) {
+		err := missingTeamError(&pgconn.PgError{Code: pgForeignKeyViolation, ConstraintName: playersTeamIDFkey},
+			gamesHomeTeamIDFkey, gamesAwayTeamIDFkey)
+
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,166 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/arsnazarenko/devops-basketball/api/gen"
+	"github.com/arsnazarenko/devops-basketball/internal/apperrors"
+	"github.com/arsnazarenko/devops-basketball/internal/usecase"
+	"github.com/arsnazarenko/devops-basketball/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+const gameColumns = "id, home_team_id, away_team_id, scheduled_at, venue, status, home_score, away_score"
+
+var _ usecase.GameRp = (*GameRepo)(nil)
+
+type GameRepo struct {
+	pg *postgres.Postgres
+}
+
+func NewGameRepo(pg *postgres.Postgres) *GameRepo {
+	return &GameRepo{
+		pg: pg,
+	}
+}
+
+// CreateGame implements usecase.GameRp.
+func (g *GameRepo) CreateGame(ctx context.Context, game *gen.GameCreate) (*gen.Game, error) {
+	query := `INSERT INTO games (home_team_id, away_team_id, scheduled_at, venue, status, home_score, away_score)
+		VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING ` + gameColumns
+
+	status := gen.Scheduled
+	if game.Status != nil {
+		status = *game.Status
+	}
+	var created gen.Game
+	err := scanGame(g.pg.Pool.QueryRow(ctx, query,
+		game.HomeTeamId,
+		game.AwayTeamId,
+		game.ScheduledAt,
+		game.Venue,
+		string(status),
+		game.HomeScore,
+		game.AwayScore,
+	), &created)
+	if err := missingTeamError(err, gamesHomeTeamIDFkey, gamesAwayTeamIDFkey); err != nil {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("repo.CreateGame: create game error: %w", constraintError(err))
+	}
+	return &created, nil
+}
+
+// UpdateGame implements usecase.GameRp.
+func (g *GameRepo) UpdateGame(ctx context.Context, gameID int64, game *gen.GameUpdate) (*gen.Game, error) {
+	query := `UPDATE games SET home_team_id = $1, away_team_id = $2, scheduled_at = $3, venue = $4,
+		status = $5, home_score = $6, away_score = $7 WHERE id = $8 RETURNING ` + gameColumns
+
+	var updated gen.Game
+	err := scanGame(g.pg.Pool.QueryRow(ctx, query,
+		game.HomeTeamId,
+		game.AwayTeamId,
+		game.ScheduledAt,
+		game.Venue,
+		string(game.Status),
+		game.HomeScore,
+		game.AwayScore,
+		gameID,
+	), &updated)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, apperrors.ErrGameNotFound
+	}
+	if err := missingTeamError(err, gamesHomeTeamIDFkey, gamesAwayTeamIDFkey); err != nil {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("repo.UpdateGame: error: %w", constraintError(err))
+	}
+	return &updated, nil
+}
+
+// DeleteGame implements usecase.GameRp.
+func (g *GameRepo) DeleteGame(ctx context.Context, gameID int64) error {
+	query := "DELETE FROM games WHERE id = $1"
+
+	res, err := g.pg.Pool.Exec(ctx, query, gameID)
+	if err != nil {
+		return fmt.Errorf("repo.DeleteGame: error: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		return apperrors.ErrGameNotFound
+	}
+	return nil
+}
+
+// GetGame implements usecase.GameRp.
+func (g *GameRepo) GetGame(ctx context.Context, gameID int64) (*gen.Game, error) {
+	query := "SELECT " + gameColumns + " FROM games WHERE id = $1"
+
+	var game gen.Game
+	if err := scanGame(g.pg.Pool.QueryRow(ctx, query, gameID), &game); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrGameNotFound
+		}
+		return nil, fmt.Errorf("repo.GetGame: error: %w", err)
+	}
+	return &game, nil
+}
+
+// GetGameList implements usecase.GameRp.
+func (g *GameRepo) GetGameList(ctx context.Context, params usecase.GameListParams) ([]gen.Game, error) {
+	if params.PageNumber < 1 {
+		return nil, apperrors.ErrInvalidGamePageNumber
+	}
+	if params.PageSize < 1 {
+		return nil, apperrors.ErrInvalidGamePageSize
+	}
+
+	var (
+		args  queryArgs
+		conds []string
+	)
+	if params.TeamID != nil {
+		team := args.add(*params.TeamID)
+		conds = append(conds, "(home_team_id = "+team+" OR away_team_id = "+team+")")
+	}
+	if params.From != nil {
+		conds = append(conds, "scheduled_at >= "+args.add(*params.From))
+	}
+	if params.To != nil {
+		conds = append(conds, "scheduled_at < "+args.add(*params.To))
+	}
+	query := "SELECT " + gameColumns + " FROM games" + whereClause(conds) +
+		" ORDER BY scheduled_at, id LIMIT " + args.add(params.PageSize) +
+		" OFFSET " + args.add((params.PageNumber-1)*params.PageSize)
+
+	rows, err := g.pg.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetGameList: error: %w", err)
+	}
+	list, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (gen.Game, error) {
+		var game gen.Game
+		err := scanGame(row, &game)
+		return game, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetGameList: error: %w", err)
+	}
+	return list, nil
+}
+
+func scanGame(row pgx.Row, game *gen.Game) error {
+	return row.Scan(
+		&game.Id,
+		&game.HomeTeamId,
+		&game.AwayTeamId,
+		&game.ScheduledAt,
+		&game.Venue,
+		&game.Status,
+		&game.HomeScore,
+		&game.AwayScore,
+	)
+}
@@ -0,0 +1,102 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/arsnazarenko/devops-basketball/internal/usecase"
+	"github.com/arsnazarenko/devops-basketball/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+var _ usecase.IdempotencyRp = (*IdempotencyRepo)(nil)
+
+type IdempotencyRepo struct {
+	pg *postgres.Postgres
+}
+
+func NewIdempotencyRepo(pg *postgres.Postgres) *IdempotencyRepo {
+	return &IdempotencyRepo{
+		pg: pg,
+	}
+}
+
+// reserveAttempts bounds how often a key is claimed again after it vanished
+// between the claim and the lookup of the earlier request.
+const reserveAttempts = 3
+
+// ReserveIdempotencyKey implements usecase.IdempotencyRp.
+// An expired key, or one whose request has not completed within lease, is
+// claimed again as if it had never been used. The created_at of the row
+// identifies the claim: a later claim always has a later one.
+func (i *IdempotencyRepo) ReserveIdempotencyKey(ctx context.Context, scope, key string, hash []byte, ttl, lease time.Duration) (time.Time, *usecase.IdempotentRequest, error) {
+	reserve := `INSERT INTO idempotency_keys (scope, key, request_hash, expires_at)
+		VALUES ($1, $2, $3, now() + make_interval(secs => $4))
+		ON CONFLICT (scope, key) DO UPDATE
+			SET request_hash = EXCLUDED.request_hash, status = NULL, headers = NULL, body = NULL,
+				created_at = now(), expires_at = EXCLUDED.expires_at
+			WHERE idempotency_keys.expires_at <= now()
+				OR idempotency_keys.status IS NULL AND idempotency_keys.created_at <= now() - make_interval(secs => $5)
+		RETURNING created_at`
+	query := "SELECT request_hash, status, headers, body FROM idempotency_keys WHERE scope = $1 AND key = $2"
+
+	for attempt := 1; ; attempt++ {
+		var claimed time.Time
+		err := i.pg.Pool.QueryRow(ctx, reserve, scope, key, hash, ttl.Seconds(), lease.Seconds()).Scan(&claimed)
+		if err == nil {
+			return claimed, nil, nil
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return time.Time{}, nil, fmt.Errorf("repo.ReserveIdempotencyKey: error: %w", err)
+		}
+
+		var (
+			request usecase.IdempotentRequest
+			status  *int
+			header  map[string]string
+			body    []byte
+		)
+		err = i.pg.Pool.QueryRow(ctx, query, scope, key).Scan(&request.Hash, &status, &header, &body)
+		if errors.Is(err, pgx.ErrNoRows) && attempt < reserveAttempts {
+			// released or deleted since the claim failed
+			continue
+		}
+		if err != nil {
+			return time.Time{}, nil, fmt.Errorf("repo.ReserveIdempotencyKey: error: %w", err)
+		}
+		if status != nil {
+			request.Response = &usecase.IdempotentResponse{Status: *status, Header: header, Body: body}
+		}
+		return time.Time{}, &request, nil
+	}
+}
+
+// CompleteIdempotencyKey implements usecase.IdempotencyRp.
+func (i *IdempotencyRepo) CompleteIdempotencyKey(ctx context.Context, scope, key string, claimed time.Time, response *usecase.IdempotentResponse) error {
+	query := "UPDATE idempotency_keys SET status = $4, headers = $5, body = $6 WHERE scope = $1 AND key = $2 AND created_at = $3"
+	if _, err := i.pg.Pool.Exec(ctx, query, scope, key, claimed, response.Status, response.Header, response.Body); err != nil {
+		return fmt.Errorf("repo.CompleteIdempotencyKey: error: %w", err)
+	}
+	return nil
+}
+
+// ReleaseIdempotencyKey implements usecase.IdempotencyRp.
+func (i *IdempotencyRepo) ReleaseIdempotencyKey(ctx context.Context, scope, key string, claimed time.Time) error {
+	query := "DELETE FROM idempotency_keys WHERE scope = $1 AND key = $2 AND created_at = $3 AND status IS NULL"
+	if _, err := i.pg.Pool.Exec(ctx, query, scope, key, claimed); err != nil {
+		return fmt.Errorf("repo.ReleaseIdempotencyKey: error: %w", err)
+	}
+	return nil
+}
+
+// DeleteExpiredIdempotencyKeys implements usecase.IdempotencyRp.
+func (i *IdempotencyRepo) DeleteExpiredIdempotencyKeys(ctx context.Context) (int64, error) {
+	query := "DELETE FROM idempotency_keys WHERE expires_at <= now()"
+	res, err := i.pg.Pool.Exec(ctx, query)
+	if err != nil {
+		return 0, fmt.Errorf("repo.DeleteExpiredIdempotencyKeys: error: %w", err)
+	}
+	return res.RowsAffected(), nil
+}
@@ -0,0 +1,73 @@
+package repo
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/arsnazarenko/devops-basketball/internal/usecase"
+	"github.com/arsnazarenko/devops-basketball/migrations"
+	"github.com/arsnazarenko/devops-basketball/pkg/migrate"
+	"github.com/arsnazarenko/devops-basketball/pkg/postgres"
+	"github.com/stretchr/testify/require"
+)
+
+// testPostgres connects to the database of TEST_POSTGRES_URL and migrates it.
+// The test is skipped when the variable is not set.
+func testPostgres(t *testing.T) *postgres.Postgres {
+	t.Helper()
+	url := os.Getenv("TEST_POSTGRES_URL")
+	if url == "" {
+		t.Skip("TEST_POSTGRES_URL is not set")
+	}
+	pg, err := postgres.New(url, postgres.MaxPoolSize(2))
+	require.NoError(t, err)
+	t.Cleanup(pg.Close)
+
+	m, err := migrate.New(pg.Pool, migrations.FS)
+	require.NoError(t, err)
+	require.NoError(t, m.Up(context.Background()))
+	return pg
+}
+
+func TestIdempotencyStaleClaim(t *testing.T) {
+	pg := testPostgres(t)
+	repo := NewIdempotencyRepo(pg)
+	ctx := context.Background()
+	scope, key, hash := "test:"+t.Name(), time.Now().Format(time.RFC3339Nano), []byte("hash")
+	t.Cleanup(func() {
+		_, _ = pg.Pool.Exec(ctx, "DELETE FROM idempotency_keys WHERE scope = $1", scope)
+	})
+
+	stale, earlier, err := repo.ReserveIdempotencyKey(ctx, scope, key, hash, time.Hour, time.Millisecond)
+	require.NoError(t, err)
+	require.Nil(t, earlier)
+
+	// the lease of the first claim expires and another request claims the key
+	time.Sleep(10 * time.Millisecond)
+	claimed, earlier, err := repo.ReserveIdempotencyKey(ctx, scope, key, hash, time.Hour, time.Millisecond)
+	require.NoError(t, err)
+	require.Nil(t, earlier)
+	require.True(t, claimed.After(stale))
+
+	t.Run("stale owner cannot release the key", func(t *testing.T) {
+		require.NoError(t, repo.ReleaseIdempotencyKey(ctx, scope, key, stale))
+
+		_, earlier, err := repo.ReserveIdempotencyKey(ctx, scope, key, hash, time.Hour, time.Hour)
+		require.NoError(t, err)
+		require.NotNil(t, earlier)
+		require.Nil(t, earlier.Response)
+	})
+
+	t.Run("stale owner cannot complete the key", func(t *testing.T) {
+		require.NoError(t, repo.CompleteIdempotencyKey(ctx, scope, key, stale, &usecase.IdempotentResponse{Status: 500}))
+		require.NoError(t, repo.CompleteIdempotencyKey(ctx, scope, key, claimed, &usecase.IdempotentResponse{Status: 201}))
+
+		_, earlier, err := repo.ReserveIdempotencyKey(ctx, scope, key, hash, time.Hour, time.Hour)
+		require.NoError(t, err)
+		require.NotNil(t, earlier)
+		require.NotNil(t, earlier.Response)
+		require.Equal(t, 201, earlier.Response.Status)
+	})
+}
@@ -0,0 +1,201 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/arsnazarenko/devops-basketball/api/gen"
+	"github.com/arsnazarenko/devops-basketball/internal/apperrors"
+	"github.com/arsnazarenko/devops-basketball/internal/metrics"
+	"github.com/arsnazarenko/devops-basketball/internal/usecase"
+)
+
+// observe records the duration of a repository method. Missing rows and
+// failed preconditions are expected outcomes and are not counted as errors.
+func observe(method string, start time.Time, err error) {
+	if errors.Is(err, apperrors.ErrPlayerNotFound) || errors.Is(err, apperrors.ErrTeamNotFound) ||
+		errors.Is(err, apperrors.ErrGameNotFound) || errors.Is(err, apperrors.ErrPlayerNotDeleted) ||
+		errors.Is(err, apperrors.ErrVersionMismatch) || errors.Is(err, apperrors.ErrForbidden) ||
+		errors.Is(err, apperrors.ErrTooManyExports) {
+		err = nil
+	}
+	metrics.RecordDBQuery(method, time.Since(start), err)
+}
+
+var _ usecase.PlayerRp = (*PlayerRepoMetrics)(nil)
+
+// PlayerRepoMetrics wraps a usecase.PlayerRp and records query metrics per method.
+type PlayerRepoMetrics struct {
+	next usecase.PlayerRp
+}
+
+func NewPlayerRepoMetrics(next usecase.PlayerRp) *PlayerRepoMetrics {
+	return &PlayerRepoMetrics{
+		next: next,
+	}
+}
+
+// CreatePlayer implements usecase.PlayerRp.
+func (p *PlayerRepoMetrics) CreatePlayer(ctx context.Context, player *gen.PlayerCreate) (created *gen.Player, err error) {
+	defer func(start time.Time) { observe("PlayerRepo.CreatePlayer", start, err) }(time.Now())
+	return p.next.CreatePlayer(ctx, player)
+}
+
+// UpdatePlayer implements usecase.PlayerRp.
+func (p *PlayerRepoMetrics) UpdatePlayer(ctx context.Context, playerID, version int64, player *gen.PlayerUpdate) (updated *gen.Player, err error) {
+	defer func(start time.Time) { observe("PlayerRepo.UpdatePlayer", start, err) }(time.Now())
+	return p.next.UpdatePlayer(ctx, playerID, version, player)
+}
+
+// DeletePlayer implements usecase.PlayerRp.
+func (p *PlayerRepoMetrics) DeletePlayer(ctx context.Context, playerID, version int64) (err error) {
+	defer func(start time.Time) { observe("PlayerRepo.DeletePlayer", start, err) }(time.Now())
+	return p.next.DeletePlayer(ctx, playerID, version)
+}
+
+// RestorePlayer implements usecase.PlayerRp.
+func (p *PlayerRepoMetrics) RestorePlayer(ctx context.Context, playerID int64) (player *gen.Player, err error) {
+	defer func(start time.Time) { observe("PlayerRepo.RestorePlayer", start, err) }(time.Now())
+	return p.next.RestorePlayer(ctx, playerID)
+}
+
+// PurgeDeletedPlayers implements usecase.PlayerRp.
+func (p *PlayerRepoMetrics) PurgeDeletedPlayers(ctx context.Context, deletedBefore time.Time) (purged int64, err error) {
+	defer func(start time.Time) { observe("PlayerRepo.PurgeDeletedPlayers", start, err) }(time.Now())
+	return p.next.PurgeDeletedPlayers(ctx, deletedBefore)
+}
+
+// GetPlayer implements usecase.PlayerRp.
+func (p *PlayerRepoMetrics) GetPlayer(ctx context.Context, playerID int64) (player *gen.Player, err error) {
+	defer func(start time.Time) { observe("PlayerRepo.GetPlayer", start, err) }(time.Now())
+	return p.next.GetPlayer(ctx, playerID)
+}
+
+// GetPlayerList implements usecase.PlayerRp.
+func (p *PlayerRepoMetrics) GetPlayerList(ctx context.Context, params usecase.PlayerListParams) (page *usecase.PlayerPage, err error) {
+	defer func(start time.Time) { observe("PlayerRepo.GetPlayerList", start, err) }(time.Now())
+	return p.next.GetPlayerList(ctx, params)
+}
+
+// CountPlayers implements usecase.PlayerRp.
+func (p *PlayerRepoMetrics) CountPlayers(ctx context.Context, filter usecase.PlayerFilter) (count uint64, err error) {
+	defer func(start time.Time) { observe("PlayerRepo.CountPlayers", start, err) }(time.Now())
+	return p.next.CountPlayers(ctx, filter)
+}
+
+// SearchPlayers implements usecase.PlayerRp.
+func (p *PlayerRepoMetrics) SearchPlayers(ctx context.Context, query string, limit uint64) (results []gen.PlayerSearchResult, err error) {
+	defer func(start time.Time) { observe("PlayerRepo.SearchPlayers", start, err) }(time.Now())
+	return p.next.SearchPlayers(ctx, query, limit)
+}
+
+// GetPlayerHistory implements usecase.PlayerRp.
+func (p *PlayerRepoMetrics) GetPlayerHistory(ctx context.Context, playerID int64, params usecase.PlayerHistoryParams) (page *usecase.PlayerHistoryPage, err error) {
+	defer func(start time.Time) { observe("PlayerRepo.GetPlayerHistory", start, err) }(time.Now())
+	return p.next.GetPlayerHistory(ctx, playerID, params)
+}
+
+// BatchPlayers implements usecase.PlayerRp.
+func (p *PlayerRepoMetrics) BatchPlayers(ctx context.Context, ops []usecase.PlayerOperation, atomic bool) (results []usecase.PlayerOperationResult, err error) {
+	defer func(start time.Time) { observe("PlayerRepo.BatchPlayers", start, err) }(time.Now())
+	return p.next.BatchPlayers(ctx, ops, atomic)
+}
+
+// ImportPlayers implements usecase.PlayerRp.
+func (p *PlayerRepoMetrics) ImportPlayers(ctx context.Context, rows []usecase.PlayerImportRow, dryRun bool) (result *usecase.PlayerImportResult, err error) {
+	defer func(start time.Time) { observe("PlayerRepo.ImportPlayers", start, err) }(time.Now())
+	return p.next.ImportPlayers(ctx, rows, dryRun)
+}
+
+// ExportPlayers implements usecase.PlayerRp. Only the query is measured, not the iteration of the cursor.
+func (p *PlayerRepoMetrics) ExportPlayers(ctx context.Context, params usecase.PlayerExportParams) (cursor usecase.PlayerCursor, err error) {
+	defer func(start time.Time) { observe("PlayerRepo.ExportPlayers", start, err) }(time.Now())
+	return p.next.ExportPlayers(ctx, params)
+}
+
+var _ usecase.TeamRp = (*TeamRepoMetrics)(nil)
+
+// TeamRepoMetrics wraps a usecase.TeamRp and records query metrics per method.
+type TeamRepoMetrics struct {
+	next usecase.TeamRp
+}
+
+func NewTeamRepoMetrics(next usecase.TeamRp) *TeamRepoMetrics {
+	return &TeamRepoMetrics{
+		next: next,
+	}
+}
+
+// CreateTeam implements usecase.TeamRp.
+func (t *TeamRepoMetrics) CreateTeam(ctx context.Context, team *gen.TeamCreate) (created *gen.Team, err error) {
+	defer func(start time.Time) { observe("TeamRepo.CreateTeam", start, err) }(time.Now())
+	return t.next.CreateTeam(ctx, team)
+}
+
+// UpdateTeam implements usecase.TeamRp.
+func (t *TeamRepoMetrics) UpdateTeam(ctx context.Context, teamID int64, team *gen.TeamUpdate) (updated *gen.Team, err error) {
+	defer func(start time.Time) { observe("TeamRepo.UpdateTeam", start, err) }(time.Now())
+	return t.next.UpdateTeam(ctx, teamID, team)
+}
+
+// DeleteTeam implements usecase.TeamRp.
+func (t *TeamRepoMetrics) DeleteTeam(ctx context.Context, teamID int64) (err error) {
+	defer func(start time.Time) { observe("TeamRepo.DeleteTeam", start, err) }(time.Now())
+	return t.next.DeleteTeam(ctx, teamID)
+}
+
+// GetTeam implements usecase.TeamRp.
+func (t *TeamRepoMetrics) GetTeam(ctx context.Context, teamID int64) (team *gen.Team, err error) {
+	defer func(start time.Time) { observe("TeamRepo.GetTeam", start, err) }(time.Now())
+	return t.next.GetTeam(ctx, teamID)
+}
+
+// GetTeamList implements usecase.TeamRp.
+func (t *TeamRepoMetrics) GetTeamList(ctx context.Context, pageSize, pageNumber uint64) (teams []gen.Team, err error) {
+	defer func(start time.Time) { observe("TeamRepo.GetTeamList", start, err) }(time.Now())
+	return t.next.GetTeamList(ctx, pageSize, pageNumber)
+}
+
+var _ usecase.GameRp = (*GameRepoMetrics)(nil)
+
+// GameRepoMetrics wraps a usecase.GameRp and records query metrics per method.
+type GameRepoMetrics struct {
+	next usecase.GameRp
+}
+
+func NewGameRepoMetrics(next usecase.GameRp) *GameRepoMetrics {
+	return &GameRepoMetrics{
+		next: next,
+	}
+}
+
+// CreateGame implements usecase.GameRp.
+func (g *GameRepoMetrics) CreateGame(ctx context.Context, game *gen.GameCreate) (created *gen.Game, err error) {
+	defer func(start time.Time) { observe("GameRepo.CreateGame", start, err) }(time.Now())
+	return g.next.CreateGame(ctx, game)
+}
+
+// UpdateGame implements usecase.GameRp.
+func (g *GameRepoMetrics) UpdateGame(ctx context.Context, gameID int64, game *gen.GameUpdate) (updated *gen.Game, err error) {
+	defer func(start time.Time) { observe("GameRepo.UpdateGame", start, err) }(time.Now())
+	return g.next.UpdateGame(ctx, gameID, game)
+}
+
+// DeleteGame implements usecase.GameRp.
+func (g *GameRepoMetrics) DeleteGame(ctx context.Context, gameID int64) (err error) {
+	defer func(start time.Time) { observe("GameRepo.DeleteGame", start, err) }(time.Now())
+	return g.next.DeleteGame(ctx, gameID)
+}
+
+// GetGame implements usecase.GameRp.
+func (g *GameRepoMetrics) GetGame(ctx context.Context, gameID int64) (game *gen.Game, err error) {
+	defer func(start time.Time) { observe("GameRepo.GetGame", start, err) }(time.Now())
+	return g.next.GetGame(ctx, gameID)
+}
+
+// GetGameList implements usecase.GameRp.
+func (g *GameRepoMetrics) GetGameList(ctx context.Context, params usecase.GameListParams) (games []gen.Game, err error) {
+	defer func(start time.Time) { observe("GameRepo.GetGameList", start, err) }(time.Now())
+	return g.next.GetGameList(ctx, params)
+}
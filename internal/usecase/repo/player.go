@@ -5,6 +5,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/arsnazarenko/devops-basketball/api/gen"
 	"github.com/arsnazarenko/devops-basketball/internal/apperrors"
@@ -15,21 +17,43 @@ import (
 
 var _ usecase.PlayerRp = (*PlayerRepo)(nil)
 
+const playerColumns = "id, name, surname, age, height, weight, citizenship, role, team_id, version, deleted_at"
+
 type PlayerRepo struct {
 	pg *postgres.Postgres
+	// exports holds a token for every running export
+	exports chan struct{}
 }
 
 func NewPlayerRepo(pg *postgres.Postgres) *PlayerRepo {
 	return &PlayerRepo{
-		pg: pg,
+		pg:      pg,
+		exports: make(chan struct{}, maxExports(pg)),
 	}
 }
 
 // CreatePlayer implements usecase.PlayerRp.
+// The player and its audit entry are written in one transaction.
 func (p *PlayerRepo) CreatePlayer(ctx context.Context, player *gen.PlayerCreate) (*gen.Player, error) {
-	query := "INSERT INTO players (name, surname, age, height, weight, citizenship, role, team_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id"
-	var id int64
-	err := p.pg.Pool.QueryRow(ctx, query,
+	var created *gen.Player
+	err := pgx.BeginFunc(ctx, p.pg.Pool, func(tx pgx.Tx) (err error) {
+		created, err = createPlayer(ctx, tx, player)
+		return err
+	})
+	if errors.Is(err, apperrors.ErrTeamNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("repo.CreatePlayer: %w", err)
+	}
+	return created, nil
+}
+
+// createPlayer inserts a player and its audit entry in tx.
+func createPlayer(ctx context.Context, tx pgx.Tx, player *gen.PlayerCreate) (*gen.Player, error) {
+	query := "INSERT INTO players (name, surname, age, height, weight, citizenship, role, team_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING " + playerColumns
+	var created gen.Player
+	err := scanPlayer(tx.QueryRow(ctx, query,
 		player.Name,
 		player.Surname,
 		player.Age,
@@ -38,54 +62,98 @@ func (p *PlayerRepo) CreatePlayer(ctx context.Context, player *gen.PlayerCreate)
 		player.Citizenship,
 		player.Role,
 		player.TeamId,
-	).Scan(&id)
-	// TODO: must check that Team with passed id exists, overwise - return apperrors.ErrTeamNotFound
+	), &created)
+	if isForeignKeyViolation(err, playersTeamIDFkey) {
+		return nil, apperrors.ErrTeamNotFound
+	}
 	if err != nil {
-		return nil, fmt.Errorf("repo.CreatePlayer: create player error: %w", err)
+		return nil, fmt.Errorf("create player error: %w", constraintError(err))
 	}
-
-	return &gen.Player{
-		Id:          id,
-		Age:         player.Age,
-		Citizenship: player.Citizenship,
-		Height:      player.Height,
-		Weight:      player.Weight,
-		Name:        player.Name,
-		Role:        gen.PlayerRole(player.Role),
-		Surname:     player.Surname,
-		TeamId:      player.TeamId,
-	}, nil
+	if err := insertAudit(ctx, tx, gen.PlayerAuditOperationCreate, created.Id, nil, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
 }
 
 // DeletePlayer implements usecase.PlayerRp.
-func (p *PlayerRepo) DeletePlayer(ctx context.Context, playerID int64) error {
-	query := "DELETE FROM players WHERE id = $1"
-	res, err := p.pg.Pool.Exec(ctx, query, playerID)
-	if err != nil {
-		return fmt.Errorf("repo.DeletePlayer: error: %w", err)
+// The player is only marked as deleted, so it can be restored until it is purged.
+// The change and its audit entry are written in one transaction.
+func (p *PlayerRepo) DeletePlayer(ctx context.Context, playerID, version int64) error {
+	err := pgx.BeginFunc(ctx, p.pg.Pool, func(tx pgx.Tx) error {
+		return deletePlayer(ctx, tx, playerID, version)
+	})
+	if errors.Is(err, apperrors.ErrPlayerNotFound) || errors.Is(err, apperrors.ErrVersionMismatch) ||
+		errors.Is(err, apperrors.ErrForbidden) {
+		return err
 	}
-	if res.RowsAffected() == 0 {
-		return apperrors.ErrPlayerNotFound
+	if err != nil {
+		return fmt.Errorf("repo.DeletePlayer: %w", err)
 	}
 	return nil
 }
 
+// deletePlayer marks a player as deleted and records its audit entry in tx.
+func deletePlayer(ctx context.Context, tx pgx.Tx, playerID, version int64) error {
+	query := "UPDATE players SET deleted_at = now(), version = version + 1 WHERE id = $1 RETURNING " + playerColumns
+	before, err := lockPlayer(ctx, tx, playerID, version)
+	if err != nil {
+		return err
+	}
+	var deleted gen.Player
+	if err := scanPlayer(tx.QueryRow(ctx, query, playerID), &deleted); err != nil {
+		return fmt.Errorf("delete player error: %w", err)
+	}
+	return insertAudit(ctx, tx, gen.PlayerAuditOperationDelete, playerID, before, &deleted)
+}
+
+// RestorePlayer implements usecase.PlayerRp.
+// The change and its audit entry are written in one transaction.
+func (p *PlayerRepo) RestorePlayer(ctx context.Context, playerID int64) (*gen.Player, error) {
+	lock := "SELECT " + playerColumns + " FROM players WHERE id = $1 FOR UPDATE"
+	query := "UPDATE players SET deleted_at = NULL, version = version + 1 WHERE id = $1 RETURNING " + playerColumns
+
+	var restored gen.Player
+	err := pgx.BeginFunc(ctx, p.pg.Pool, func(tx pgx.Tx) error {
+		var before gen.Player
+		if err := scanPlayer(tx.QueryRow(ctx, lock, playerID), &before); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apperrors.ErrPlayerNotFound
+			}
+			return fmt.Errorf("lock player error: %w", err)
+		}
+		if before.DeletedAt == nil {
+			return apperrors.ErrPlayerNotDeleted
+		}
+		if err := scanPlayer(tx.QueryRow(ctx, query, playerID), &restored); err != nil {
+			return fmt.Errorf("restore player error: %w", err)
+		}
+		return insertAudit(ctx, tx, gen.PlayerAuditOperationRestore, playerID, &before, &restored)
+	})
+	if errors.Is(err, apperrors.ErrPlayerNotFound) || errors.Is(err, apperrors.ErrPlayerNotDeleted) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("repo.RestorePlayer: %w", err)
+	}
+	return &restored, nil
+}
+
+// PurgeDeletedPlayers implements usecase.PlayerRp.
+func (p *PlayerRepo) PurgeDeletedPlayers(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	query := "DELETE FROM players WHERE deleted_at < $1"
+	res, err := p.pg.Pool.Exec(ctx, query, deletedBefore)
+	if err != nil {
+		return 0, fmt.Errorf("repo.PurgeDeletedPlayers: error: %w", err)
+	}
+	return res.RowsAffected(), nil
+}
+
 // GetPlayer implements usecase.PlayerRp.
 func (p *PlayerRepo) GetPlayer(ctx context.Context, playerID int64) (*gen.Player, error) {
-	query := "SELECT id, name, surname, age, height, weight, citizenship, role, team_id FROM players WHERE id = $1"
+	query := "SELECT " + playerColumns + " FROM players WHERE id = $1 AND deleted_at IS NULL"
 
 	var player gen.Player
-	if err := p.pg.Pool.QueryRow(ctx, query, playerID).Scan(
-		&player.Id,
-		&player.Name,
-		&player.Surname,
-		&player.Age,
-		&player.Height,
-		&player.Weight,
-		&player.Citizenship,
-		&player.Role,
-		&player.TeamId,
-	); err != nil {
+	if err := scanPlayer(p.pg.Pool.QueryRow(ctx, query, playerID), &player); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, apperrors.ErrPlayerNotFound
 		}
@@ -95,75 +163,228 @@ func (p *PlayerRepo) GetPlayer(ctx context.Context, playerID int64) (*gen.Player
 }
 
 // GetPlayerList implements usecase.PlayerRp.
-func (p *PlayerRepo) GetPlayerList(ctx context.Context, pageSize uint64, pageNumber uint64) ([]gen.Player, error) {
-	if pageNumber < 1 {
+func (p *PlayerRepo) GetPlayerList(ctx context.Context, params usecase.PlayerListParams) (*usecase.PlayerPage, error) {
+	if params.Cursor == "" && params.PageNumber < 1 {
 		return nil, apperrors.ErrInvalidPlayerPageNumber
 	}
-	if pageSize < 1 {
+	if params.PageSize < 1 {
 		return nil, apperrors.ErrInvalidPlayerPageSize
 	}
-	limit, offset := pageSize, (pageNumber-1)*pageSize
-	query := "SELECT id, name, surname, age, height, weight, citizenship, role, team_id FROM players LIMIT $1 OFFSET $2"
-	rows, err := p.pg.Pool.Query(ctx, query, limit, offset)
+	sort, err := normalizePlayerSort(params.Sort)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, apperrors.ErrPlayerNotFound
+		return nil, err
+	}
+
+	var args queryArgs
+	var offset uint64
+	conds := playerConditions(params.Filter, &args)
+	if params.Cursor != "" {
+		values, err := decodePlayerCursor(params.Cursor, sort)
+		if err != nil {
+			return nil, err
 		}
+		conds = append(conds, keysetCondition(sort, values, &args))
+	} else {
+		offset = (params.PageNumber - 1) * params.PageSize
+	}
+	// one extra row tells whether there is a next page
+	query := "SELECT " + playerColumns + " FROM players" + whereClause(conds) + orderByClause(sort) +
+		" LIMIT " + args.add(params.PageSize+1) + " OFFSET " + args.add(offset)
+
+	rows, err := p.pg.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetPlayerList: error: %w", err)
 	}
 	defer rows.Close()
 	list := []gen.Player{}
 	for rows.Next() {
 		var player gen.Player
-		if err := rows.Scan(
-			&player.Id,
-			&player.Name,
-			&player.Surname,
-			&player.Age,
-			&player.Height,
-			&player.Weight,
-			&player.Citizenship,
-			&player.Role,
-			&player.TeamId,
-		); err != nil {
+		if err := scanPlayer(rows, &player); err != nil {
 			return nil, fmt.Errorf("repo.GetPlayerList: error: %w", err)
 		}
 		list = append(list, player)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.GetPlayerList: error: %w", err)
+	}
+
+	page := &usecase.PlayerPage{Players: list}
+	if uint64(len(list)) > params.PageSize {
+		page.Players = list[:params.PageSize]
+		page.NextCursor = encodePlayerCursor(sort, &page.Players[len(page.Players)-1])
+	}
+	return page, nil
+}
+
+// CountPlayers implements usecase.PlayerRp.
+func (p *PlayerRepo) CountPlayers(ctx context.Context, filter usecase.PlayerFilter) (uint64, error) {
+	var args queryArgs
+	query := "SELECT count(*) FROM players" + whereClause(playerConditions(filter, &args))
+
+	var total int64
+	if err := p.pg.Pool.QueryRow(ctx, query, args...).Scan(&total); err != nil {
+		return 0, fmt.Errorf("repo.CountPlayers: error: %w", err)
+	}
+	return uint64(total), nil
+}
+
+// SearchPlayers implements usecase.PlayerRp.
+// Players match when the full-text query matches their name and surname, or when the
+// query is similar enough to a word sequence of them. Both scores add up to the rank.
+// Diacritics are ignored on both sides, "Doncic" finds "Dončić".
+func (p *PlayerRepo) SearchPlayers(ctx context.Context, query string, limit uint64) ([]gen.PlayerSearchResult, error) {
+	sql := `WITH q AS (SELECT f_unaccent($1) AS text, websearch_to_tsquery('simple', f_unaccent($1)) AS tsq)
+		SELECT ` + playerColumns + `,
+			(ts_rank(search_vector, q.tsq) + word_similarity(q.text, f_unaccent(name || ' ' || surname)))::float8 AS rank
+		FROM players, q
+		WHERE (search_vector @@ q.tsq OR q.text <% f_unaccent(name || ' ' || surname)) AND deleted_at IS NULL
+		ORDER BY rank DESC, id
+		LIMIT $2`
+
+	rows, err := p.pg.Pool.Query(ctx, sql, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("repo.SearchPlayers: error: %w", err)
+	}
+	defer rows.Close()
+	list := []gen.PlayerSearchResult{}
+	for rows.Next() {
+		var result gen.PlayerSearchResult
+		if err := rows.Scan(
+			&result.Player.Id,
+			&result.Player.Name,
+			&result.Player.Surname,
+			&result.Player.Age,
+			&result.Player.Height,
+			&result.Player.Weight,
+			&result.Player.Citizenship,
+			&result.Player.Role,
+			&result.Player.TeamId,
+			&result.Player.Version,
+			&result.Player.DeletedAt,
+			&result.Rank,
+		); err != nil {
+			return nil, fmt.Errorf("repo.SearchPlayers: error: %w", err)
+		}
+		list = append(list, result)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.SearchPlayers: error: %w", err)
+	}
 	return list, nil
 }
 
 // UpdatePlayer implements usecase.PlayerRp.
-func (p *PlayerRepo) UpdatePlayer(ctx context.Context, playerID int64, player *gen.PlayerUpdate) (*gen.Player, error) {
-	query := "UPDATE players SET name = $1, surname = $2, age = $3, height = $4, weight = $5, citizenship = $6, role = $7, team_id = $8 WHERE id = $9 RETURNING *"
+// Only the fields set in player are written, the rest keep their current values.
+// The row is locked to check its version and to record the player before the
+// change in the same transaction.
+func (p *PlayerRepo) UpdatePlayer(ctx context.Context, playerID, version int64, player *gen.PlayerUpdate) (*gen.Player, error) {
+	var updated *gen.Player
+	err := pgx.BeginFunc(ctx, p.pg.Pool, func(tx pgx.Tx) (err error) {
+		updated, err = updatePlayer(ctx, tx, playerID, version, player)
+		return err
+	})
+	if errors.Is(err, apperrors.ErrPlayerNotFound) || errors.Is(err, apperrors.ErrTeamNotFound) ||
+		errors.Is(err, apperrors.ErrVersionMismatch) || errors.Is(err, apperrors.ErrForbidden) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("repo.UpdatePlayer: %w", err)
+	}
+	return updated, nil
+}
+
+// updatePlayer writes the fields set in player and records the audit entry in tx.
+// Without fields to write the player is returned unchanged.
+func updatePlayer(ctx context.Context, tx pgx.Tx, playerID, version int64, player *gen.PlayerUpdate) (*gen.Player, error) {
+	var (
+		sets []string
+		args []any
+	)
+	set := func(column string, value any) {
+		args = append(args, value)
+		sets = append(sets, fmt.Sprintf("%s = $%d", column, len(args)))
+	}
+	if player.Name != nil {
+		set("name", *player.Name)
+	}
+	if player.Surname != nil {
+		set("surname", *player.Surname)
+	}
+	if player.Age != nil {
+		set("age", *player.Age)
+	}
+	if player.Height != nil {
+		set("height", *player.Height)
+	}
+	if player.Weight != nil {
+		set("weight", *player.Weight)
+	}
+	if player.Citizenship != nil {
+		set("citizenship", *player.Citizenship)
+	}
+	if player.Role != nil {
+		set("role", *player.Role)
+	}
+	if player.TeamId != nil {
+		set("team_id", *player.TeamId)
+	}
+
+	before, err := lockPlayer(ctx, tx, playerID, version)
+	if err != nil || len(sets) == 0 {
+		return before, err
+	}
+
+	sets = append(sets, "version = version + 1")
+	args = append(args, playerID)
+	query := fmt.Sprintf("UPDATE players SET %s WHERE id = $%d RETURNING %s", strings.Join(sets, ", "), len(args), playerColumns)
 
 	var updated gen.Player
-	row := p.pg.Pool.QueryRow(ctx, query,
-		player.Name,
-		player.Surname,
-		player.Age,
-		player.Height,
-		player.Weight,
-		player.Citizenship,
-		player.Role,
-		player.TeamId,
-		playerID,
+	if err := scanPlayer(tx.QueryRow(ctx, query, args...), &updated); err != nil {
+		if isForeignKeyViolation(err, playersTeamIDFkey) {
+			return nil, apperrors.ErrTeamNotFound
+		}
+		return nil, fmt.Errorf("update player error: %w", constraintError(err))
+	}
+	if err := insertAudit(ctx, tx, gen.PlayerAuditOperationUpdate, playerID, before, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// scanPlayer scans a row selected with playerColumns.
+func scanPlayer(row pgx.Row, player *gen.Player) error {
+	return row.Scan(
+		&player.Id,
+		&player.Name,
+		&player.Surname,
+		&player.Age,
+		&player.Height,
+		&player.Weight,
+		&player.Citizenship,
+		&player.Role,
+		&player.TeamId,
+		&player.Version,
+		&player.DeletedAt,
 	)
-	if err := row.Scan(
-		&updated.Id,
-		&updated.Name,
-		&updated.Surname,
-		&updated.Age,
-		&updated.Height,
-		&updated.Weight,
-		&updated.Citizenship,
-		&updated.Role,
-		&updated.TeamId,
-	); err != nil {
+}
+
+// lockPlayer selects a live player for update in tx and checks that it is at
+// the given version, unless version is usecase.AnyVersion. A player outside
+// the usecase.TeamScope of ctx is forbidden.
+func lockPlayer(ctx context.Context, tx pgx.Tx, playerID, version int64) (*gen.Player, error) {
+	query := "SELECT " + playerColumns + " FROM players WHERE id = $1 AND deleted_at IS NULL FOR UPDATE"
+	var player gen.Player
+	if err := scanPlayer(tx.QueryRow(ctx, query, playerID), &player); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, apperrors.ErrPlayerNotFound
 		}
-		// TODO: must check that Team with passed id exists, overwise - return apperrors.ErrTeamNotFound
-		return nil, fmt.Errorf("repo.UpdatePlayer: error: %w", err)
+		return nil, fmt.Errorf("lock player error: %w", err)
 	}
-	return &updated, nil
+	if teamID, ok := usecase.TeamScope(ctx); ok && player.TeamId != teamID {
+		return nil, apperrors.ErrForbidden
+	}
+	if version != usecase.AnyVersion && player.Version != version {
+		return nil, apperrors.ErrVersionMismatch
+	}
+	return &player, nil
 }
@@ -0,0 +1,112 @@
+package repo
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+
+	"github.com/arsnazarenko/devops-basketball/api/gen"
+	"github.com/arsnazarenko/devops-basketball/internal/apperrors"
+	"github.com/arsnazarenko/devops-basketball/internal/auth"
+	"github.com/arsnazarenko/devops-basketball/internal/usecase"
+	"github.com/jackc/pgx/v5"
+)
+
+// systemActor is recorded for changes made without an authenticated caller.
+const systemActor = "system"
+
+// actor identifies the caller in ctx as "<method>:<subject>".
+func actor(ctx context.Context) string {
+	p, ok := auth.PrincipalFrom(ctx)
+	if !ok {
+		return systemActor
+	}
+	return p.Method + ":" + p.Subject
+}
+
+// insertAudit records a change of a player in tx. before is nil for a create
+// and after is nil for a delete.
+func insertAudit(ctx context.Context, tx pgx.Tx, op gen.PlayerAuditOperation, playerID int64, before, after *gen.Player) error {
+	query := "INSERT INTO player_audit (player_id, actor, operation, before, after) VALUES ($1, $2, $3, $4, $5)"
+	if _, err := tx.Exec(ctx, query, playerID, actor(ctx), op, before, after); err != nil {
+		return fmt.Errorf("insert audit error: %w", err)
+	}
+	return nil
+}
+
+// encodeHistoryCursor returns the cursor continuing a history after the entry with the given id.
+func encodeHistoryCursor(id int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(id, 10)))
+}
+
+// decodeHistoryCursor returns the id of the last entry of the previous page.
+func decodeHistoryCursor(cursor string) (int64, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, apperrors.ErrInvalidHistoryCursor
+	}
+	id, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil || id < 1 {
+		return 0, apperrors.ErrInvalidHistoryCursor
+	}
+	return id, nil
+}
+
+// GetPlayerHistory implements usecase.PlayerRp.
+// The history of a deleted player stays available.
+func (p *PlayerRepo) GetPlayerHistory(ctx context.Context, playerID int64, params usecase.PlayerHistoryParams) (*usecase.PlayerHistoryPage, error) {
+	if params.PageSize < 1 {
+		return nil, apperrors.ErrInvalidPlayerPageSize
+	}
+	var args queryArgs
+	conds := []string{"player_id = " + args.add(playerID)}
+	if params.Cursor != "" {
+		after, err := decodeHistoryCursor(params.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		conds = append(conds, "id < "+args.add(after))
+	}
+	// one extra row tells whether there is a next page
+	query := "SELECT id, player_id, actor, operation, changed_at, before, after FROM player_audit" +
+		whereClause(conds) + " ORDER BY id DESC LIMIT " + args.add(params.PageSize+1)
+
+	rows, err := p.pg.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetPlayerHistory: error: %w", err)
+	}
+	defer rows.Close()
+	entries := []gen.PlayerAuditEntry{}
+	for rows.Next() {
+		var entry gen.PlayerAuditEntry
+		if err := rows.Scan(
+			&entry.Id,
+			&entry.PlayerId,
+			&entry.Actor,
+			&entry.Operation,
+			&entry.ChangedAt,
+			&entry.Before,
+			&entry.After,
+		); err != nil {
+			return nil, fmt.Errorf("repo.GetPlayerHistory: error: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.GetPlayerHistory: error: %w", err)
+	}
+
+	if len(entries) == 0 && params.Cursor == "" {
+		// players created before auditing started have no history yet
+		if _, err := p.GetPlayer(ctx, playerID); err != nil {
+			return nil, err
+		}
+	}
+	page := &usecase.PlayerHistoryPage{Entries: entries}
+	if uint64(len(entries)) > params.PageSize {
+		page.Entries = entries[:params.PageSize]
+		page.NextCursor = encodeHistoryCursor(page.Entries[len(page.Entries)-1].Id)
+	}
+	return page, nil
+}
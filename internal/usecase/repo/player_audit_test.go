@@ -0,0 +1,19 @@
+package repo
+
+import (
+	"testing"
+
+	"github.com/arsnazarenko/devops-basketball/internal/apperrors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistoryCursor(t *testing.T) {
+	id, err := decodeHistoryCursor(encodeHistoryCursor(42))
+	require.NoError(t, err)
+	require.Equal(t, int64(42), id)
+
+	for _, cursor := range []string{"!!", "YWJj", "MA", "LTE"} {
+		_, err := decodeHistoryCursor(cursor)
+		require.ErrorIs(t, err, apperrors.ErrInvalidHistoryCursor, cursor)
+	}
+}
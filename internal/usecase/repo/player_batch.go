@@ -0,0 +1,82 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/arsnazarenko/devops-basketball/api/gen"
+	"github.com/arsnazarenko/devops-basketball/internal/apperrors"
+	"github.com/arsnazarenko/devops-basketball/internal/usecase"
+	"github.com/jackc/pgx/v5"
+)
+
+// errBatchFailed rolls back an atomic batch after one of its operations failed.
+var errBatchFailed = errors.New("batch operation failed")
+
+// BatchPlayers implements usecase.PlayerRp.
+// Each operation writes its audit entry in the transaction it runs in.
+func (p *PlayerRepo) BatchPlayers(ctx context.Context, ops []usecase.PlayerOperation, atomic bool) ([]usecase.PlayerOperationResult, error) {
+	results := make([]usecase.PlayerOperationResult, len(ops))
+	if !atomic {
+		for i, op := range ops {
+			err := pgx.BeginFunc(ctx, p.pg.Pool, func(tx pgx.Tx) (err error) {
+				results[i].Player, err = applyOperation(ctx, tx, op)
+				return err
+			})
+			if err != nil {
+				results[i] = usecase.PlayerOperationResult{Err: batchError(err)}
+			}
+		}
+		return results, nil
+	}
+
+	failed := -1
+	err := pgx.BeginFunc(ctx, p.pg.Pool, func(tx pgx.Tx) error {
+		for i, op := range ops {
+			player, err := applyOperation(ctx, tx, op)
+			if err != nil {
+				failed = i
+				results[i].Err = batchError(err)
+				return errBatchFailed
+			}
+			results[i].Player = player
+		}
+		return nil
+	})
+	if failed >= 0 {
+		for i := range results {
+			if i != failed {
+				results[i] = usecase.PlayerOperationResult{Err: apperrors.ErrBatchAborted}
+			}
+		}
+		return results, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("repo.BatchPlayers: %w", err)
+	}
+	return results, nil
+}
+
+// applyOperation runs a single batch operation in tx.
+func applyOperation(ctx context.Context, tx pgx.Tx, op usecase.PlayerOperation) (*gen.Player, error) {
+	switch {
+	case op.Op == gen.PlayerBatchOperationOpCreate && op.Create != nil:
+		return createPlayer(ctx, tx, op.Create)
+	case op.Op == gen.PlayerBatchOperationOpUpdate && op.Update != nil:
+		return updatePlayer(ctx, tx, op.ID, op.Version, op.Update)
+	case op.Op == gen.PlayerBatchOperationOpDelete:
+		return nil, deletePlayer(ctx, tx, op.ID, op.Version)
+	}
+	return nil, apperrors.ErrInvalidBatchOperation
+}
+
+// batchError keeps the apperrors of an operation and wraps the others.
+func batchError(err error) error {
+	if errors.Is(err, apperrors.ErrPlayerNotFound) || errors.Is(err, apperrors.ErrTeamNotFound) ||
+		errors.Is(err, apperrors.ErrVersionMismatch) || errors.Is(err, apperrors.ErrInvalidBatchOperation) ||
+		errors.Is(err, apperrors.ErrForbidden) {
+		return err
+	}
+	return fmt.Errorf("repo.BatchPlayers: %w", err)
+}
@@ -0,0 +1,138 @@
+package repo
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"github.com/arsnazarenko/devops-basketball/api/gen"
+	"github.com/arsnazarenko/devops-basketball/internal/apperrors"
+	"github.com/arsnazarenko/devops-basketball/internal/usecase"
+)
+
+// playerCursor is the decoded form of an opaque player listing cursor. It holds
+// the sort keys of the listing and their values in the last player of a page.
+type playerCursor struct {
+	Sort   string `json:"s"`
+	Values []any  `json:"v"`
+}
+
+// sortSignature renders normalized sort keys like "-age,surname,id".
+func sortSignature(keys []usecase.PlayerSort) string {
+	terms := make([]string, 0, len(keys))
+	for _, key := range keys {
+		term := string(key.Field)
+		if key.Desc {
+			term = "-" + term
+		}
+		terms = append(terms, term)
+	}
+	return strings.Join(terms, ",")
+}
+
+// playerSortValue returns the value of the sort field in player.
+func playerSortValue(player *gen.Player, field usecase.PlayerSortField) any {
+	switch field {
+	case usecase.PlayerSortName:
+		return player.Name
+	case usecase.PlayerSortSurname:
+		return player.Surname
+	case usecase.PlayerSortAge:
+		return int64(player.Age)
+	case usecase.PlayerSortHeight:
+		return int64(player.Height)
+	case usecase.PlayerSortWeight:
+		return int64(player.Weight)
+	case usecase.PlayerSortCitizenship:
+		return player.Citizenship
+	case usecase.PlayerSortRole:
+		return string(player.Role)
+	case usecase.PlayerSortTeamID:
+		return player.TeamId
+	default:
+		return player.Id
+	}
+}
+
+// encodePlayerCursor returns the cursor continuing a listing after player.
+func encodePlayerCursor(keys []usecase.PlayerSort, player *gen.Player) string {
+	cursor := playerCursor{
+		Sort:   sortSignature(keys),
+		Values: make([]any, 0, len(keys)),
+	}
+	for _, key := range keys {
+		cursor.Values = append(cursor.Values, playerSortValue(player, key.Field))
+	}
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodePlayerCursor returns the sort values held by cursor. The cursor must
+// have been issued for the same sort keys.
+func decodePlayerCursor(cursor string, keys []usecase.PlayerSort) ([]any, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, apperrors.ErrInvalidPlayerCursor
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var decoded playerCursor
+	if err := dec.Decode(&decoded); err != nil {
+		return nil, apperrors.ErrInvalidPlayerCursor
+	}
+	if decoded.Sort != sortSignature(keys) || len(decoded.Values) != len(keys) {
+		return nil, apperrors.ErrInvalidPlayerCursor
+	}
+
+	values := make([]any, len(keys))
+	for i, key := range keys {
+		switch v := decoded.Values[i].(type) {
+		case json.Number:
+			if _, isString := playerSortValue(&gen.Player{}, key.Field).(string); isString {
+				return nil, apperrors.ErrInvalidPlayerCursor
+			}
+			n, err := v.Int64()
+			if err != nil {
+				return nil, apperrors.ErrInvalidPlayerCursor
+			}
+			values[i] = n
+		case string:
+			if _, isString := playerSortValue(&gen.Player{}, key.Field).(string); !isString {
+				return nil, apperrors.ErrInvalidPlayerCursor
+			}
+			values[i] = v
+		default:
+			return nil, apperrors.ErrInvalidPlayerCursor
+		}
+	}
+	return values, nil
+}
+
+// keysetCondition returns the condition selecting the rows that come after
+// values in the order of keys:
+//
+//	k1 > v1 OR (k1 = v1 AND k2 > v2) OR ...
+//
+// with "<" instead of ">" for descending keys.
+func keysetCondition(keys []usecase.PlayerSort, values []any, args *queryArgs) string {
+	placeholders := make([]string, len(values))
+	for i, v := range values {
+		placeholders[i] = args.add(v)
+	}
+
+	alternatives := make([]string, 0, len(keys))
+	for i, key := range keys {
+		terms := make([]string, 0, i+1)
+		for j := 0; j < i; j++ {
+			terms = append(terms, playerSortColumns[keys[j].Field]+" = "+placeholders[j])
+		}
+		op := " > "
+		if key.Desc {
+			op = " < "
+		}
+		terms = append(terms, playerSortColumns[key.Field]+op+placeholders[i])
+		alternatives = append(alternatives, "("+strings.Join(terms, " AND ")+")")
+	}
+	return "(" + strings.Join(alternatives, " OR ") + ")"
+}
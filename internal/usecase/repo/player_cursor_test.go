@@ -0,0 +1,59 @@
+package repo
+
+import (
+	"testing"
+
+	"github.com/arsnazarenko/devops-basketball/api/gen"
+	"github.com/arsnazarenko/devops-basketball/internal/apperrors"
+	"github.com/arsnazarenko/devops-basketball/internal/usecase"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlayerCursor(t *testing.T) {
+	keys, err := normalizePlayerSort([]usecase.PlayerSort{
+		{Field: usecase.PlayerSortAge, Desc: true},
+		{Field: usecase.PlayerSortSurname},
+	})
+	require.NoError(t, err)
+
+	player := &gen.Player{Id: 12, Surname: "James", Age: 39}
+
+	t.Run("round trip", func(t *testing.T) {
+		values, err := decodePlayerCursor(encodePlayerCursor(keys, player), keys)
+		require.NoError(t, err)
+		require.Equal(t, []any{int64(39), "James", int64(12)}, values)
+	})
+
+	t.Run("different sort", func(t *testing.T) {
+		other, err := normalizePlayerSort([]usecase.PlayerSort{{Field: usecase.PlayerSortAge}})
+		require.NoError(t, err)
+
+		_, err = decodePlayerCursor(encodePlayerCursor(keys, player), other)
+		require.ErrorIs(t, err, apperrors.ErrInvalidPlayerCursor)
+	})
+
+	t.Run("garbage", func(t *testing.T) {
+		_, err := decodePlayerCursor("not a cursor", keys)
+		require.ErrorIs(t, err, apperrors.ErrInvalidPlayerCursor)
+	})
+
+	t.Run("keyset condition", func(t *testing.T) {
+		var args queryArgs
+		args.add(int64(1)) // a preceding filter argument
+		cond := keysetCondition(keys, []any{int64(39), "James", int64(12)}, &args)
+		require.Equal(t, "((age < $2) OR (age = $2 AND surname > $3) OR (age = $2 AND surname = $3 AND id > $4))", cond)
+		require.Equal(t, queryArgs{int64(1), int64(39), "James", int64(12)}, args)
+	})
+}
+
+func TestNormalizePlayerSort(t *testing.T) {
+	keys, err := normalizePlayerSort([]usecase.PlayerSort{
+		{Field: usecase.PlayerSortID, Desc: true},
+		{Field: usecase.PlayerSortID},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []usecase.PlayerSort{{Field: usecase.PlayerSortID, Desc: true}}, keys)
+
+	_, err = normalizePlayerSort([]usecase.PlayerSort{{Field: "salary"}})
+	require.ErrorIs(t, err, apperrors.ErrInvalidPlayerSort)
+}
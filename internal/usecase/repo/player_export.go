@@ -0,0 +1,101 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/arsnazarenko/devops-basketball/api/gen"
+	"github.com/arsnazarenko/devops-basketball/internal/apperrors"
+	"github.com/arsnazarenko/devops-basketball/internal/usecase"
+	"github.com/arsnazarenko/devops-basketball/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+// maxExports returns how many exports may run at once: a quarter of the
+// connection pool, so that exports cannot starve the other queries.
+func maxExports(pg *postgres.Postgres) int {
+	return max(1, int(pg.Pool.Config().MaxConns)/4)
+}
+
+// playerRows is a usecase.PlayerCursor over the rows of a player query run in
+// tx. Closing it ends tx and frees the export slot.
+type playerRows struct {
+	tx      pgx.Tx
+	rows    pgx.Rows
+	err     error
+	release func()
+}
+
+func (c *playerRows) Next(player *gen.Player) bool {
+	if c.err != nil || !c.rows.Next() {
+		return false
+	}
+	if err := scanPlayer(c.rows, player); err != nil {
+		c.err = err
+		c.rows.Close()
+		return false
+	}
+	return true
+}
+
+func (c *playerRows) Err() error {
+	err := c.err
+	if err == nil {
+		err = c.rows.Err()
+	}
+	if err != nil {
+		return fmt.Errorf("repo.ExportPlayers: error: %w", err)
+	}
+	return nil
+}
+
+func (c *playerRows) Close() {
+	if c.tx == nil {
+		return
+	}
+	c.rows.Close()
+	_ = c.tx.Rollback(context.Background())
+	c.tx = nil
+	c.release()
+}
+
+// ExportPlayers implements usecase.PlayerRp.
+// The query runs in a read-only transaction without statement_timeout, since
+// it lasts as long as the client takes to read the export. Exports beyond
+// maxExports fail with apperrors.ErrTooManyExports.
+func (p *PlayerRepo) ExportPlayers(ctx context.Context, params usecase.PlayerExportParams) (usecase.PlayerCursor, error) {
+	sort, err := normalizePlayerSort(params.Sort)
+	if err != nil {
+		return nil, err
+	}
+	var args queryArgs
+	query := "SELECT " + playerColumns + " FROM players" + whereClause(playerConditions(params.Filter, &args)) + orderByClause(sort)
+
+	select {
+	case p.exports <- struct{}{}:
+	default:
+		return nil, apperrors.ErrTooManyExports
+	}
+	release := func() { <-p.exports }
+
+	tx, err := p.pg.Pool.BeginTx(ctx, pgx.TxOptions{AccessMode: pgx.ReadOnly})
+	if err != nil {
+		release()
+		return nil, fmt.Errorf("repo.ExportPlayers: error: %w", err)
+	}
+	rows, err := exportQuery(ctx, tx, query, args)
+	if err != nil {
+		_ = tx.Rollback(context.Background())
+		release()
+		return nil, fmt.Errorf("repo.ExportPlayers: error: %w", err)
+	}
+	return &playerRows{tx: tx, rows: rows, release: release}, nil
+}
+
+// exportQuery lifts the statement timeout of tx and runs query in it.
+func exportQuery(ctx context.Context, tx pgx.Tx, query string, args queryArgs) (pgx.Rows, error) {
+	if _, err := tx.Exec(ctx, "SET LOCAL statement_timeout = 0"); err != nil {
+		return nil, err
+	}
+	return tx.Query(ctx, query, args...)
+}
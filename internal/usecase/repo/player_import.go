@@ -0,0 +1,139 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/arsnazarenko/devops-basketball/api/gen"
+	"github.com/arsnazarenko/devops-basketball/internal/apperrors"
+	"github.com/arsnazarenko/devops-basketball/internal/usecase"
+	"github.com/jackc/pgx/v5"
+)
+
+// errDryRun rolls back the transaction of a dry run import.
+var errDryRun = errors.New("dry run")
+
+// ImportPlayers implements usecase.PlayerRp.
+// The rows are copied into a temporary table and inserted from there, so that
+// the created players can be returned in upload order and audited like single creates.
+// A dry run inserts the rows too and rolls back. Sequences are not rolled back,
+// so it consumes player ids like a real import would.
+func (p *PlayerRepo) ImportPlayers(ctx context.Context, rows []usecase.PlayerImportRow, dryRun bool) (*usecase.PlayerImportResult, error) {
+	createTable := `CREATE TEMP TABLE player_import (
+		line INTEGER NOT NULL,
+		name TEXT,
+		surname TEXT,
+		age INTEGER,
+		height INTEGER,
+		weight INTEGER,
+		citizenship TEXT,
+		role TEXT,
+		team_id BIGINT
+	) ON COMMIT DROP`
+	insert := `INSERT INTO players (name, surname, age, height, weight, citizenship, role, team_id)
+		SELECT name, surname, age, height, weight, citizenship, role, team_id FROM player_import ORDER BY line
+		RETURNING ` + playerColumns
+
+	result := &usecase.PlayerImportResult{}
+	valid, err := p.rejectUnknownTeams(ctx, rows, result)
+	if err != nil {
+		return nil, err
+	}
+	if len(valid) == 0 {
+		return result, nil
+	}
+
+	err = pgx.BeginFunc(ctx, p.pg.Pool, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, createTable); err != nil {
+			return fmt.Errorf("create import table error: %w", err)
+		}
+		_, err := tx.CopyFrom(ctx, pgx.Identifier{"player_import"},
+			[]string{"line", "name", "surname", "age", "height", "weight", "citizenship", "role", "team_id"},
+			pgx.CopyFromSlice(len(valid), func(i int) ([]any, error) {
+				player := &valid[i].Player
+				return []any{valid[i].Line, player.Name, player.Surname, player.Age, player.Height,
+					player.Weight, player.Citizenship, string(player.Role), player.TeamId}, nil
+			}),
+		)
+		if err != nil {
+			return fmt.Errorf("copy players error: %w", err)
+		}
+
+		created, err := tx.Query(ctx, insert)
+		if err != nil {
+			return fmt.Errorf("insert players error: %w", err)
+		}
+		players, err := pgx.CollectRows(created, func(row pgx.CollectableRow) (gen.Player, error) {
+			var player gen.Player
+			err := scanPlayer(row, &player)
+			return player, err
+		})
+		if err != nil {
+			if isForeignKeyViolation(err, playersTeamIDFkey) {
+				// a team was deleted since it was checked
+				return apperrors.ErrTeamNotFound
+			}
+			return fmt.Errorf("insert players error: %w", constraintError(err))
+		}
+
+		caller := actor(ctx)
+		_, err = tx.CopyFrom(ctx, pgx.Identifier{"player_audit"},
+			[]string{"player_id", "actor", "operation", "after"},
+			pgx.CopyFromSlice(len(players), func(i int) ([]any, error) {
+				return []any{players[i].Id, caller, string(gen.PlayerAuditOperationCreate), &players[i]}, nil
+			}),
+		)
+		if err != nil {
+			return fmt.Errorf("insert audit error: %w", err)
+		}
+		result.Imported = len(players)
+		if dryRun {
+			return errDryRun
+		}
+		return nil
+	})
+	if errors.Is(err, apperrors.ErrTeamNotFound) {
+		return nil, err
+	}
+	if err != nil && !errors.Is(err, errDryRun) {
+		return nil, fmt.Errorf("repo.ImportPlayers: %w", err)
+	}
+	return result, nil
+}
+
+// rejectUnknownTeams adds the rows of teams that do not exist to the rejected
+// rows of result and returns the others.
+func (p *PlayerRepo) rejectUnknownTeams(ctx context.Context, rows []usecase.PlayerImportRow, result *usecase.PlayerImportResult) ([]usecase.PlayerImportRow, error) {
+	query := "SELECT id FROM teams WHERE id = ANY($1)"
+
+	ids := make([]int64, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.Player.TeamId)
+	}
+	found, err := p.pg.Pool.Query(ctx, query, ids)
+	if err != nil {
+		return nil, fmt.Errorf("repo.ImportPlayers: error: %w", err)
+	}
+	existing, err := pgx.CollectRows(found, pgx.RowTo[int64])
+	if err != nil {
+		return nil, fmt.Errorf("repo.ImportPlayers: error: %w", err)
+	}
+	teams := make(map[int64]bool, len(existing))
+	for _, id := range existing {
+		teams[id] = true
+	}
+
+	valid := make([]usecase.PlayerImportRow, 0, len(rows))
+	for _, row := range rows {
+		if !teams[row.Player.TeamId] {
+			result.Rejected = append(result.Rejected, gen.PlayerImportRejection{
+				Line:   row.Line,
+				Errors: []gen.FieldError{{Field: "teamId", Message: apperrors.ErrTeamNotFound.Error()}},
+			})
+			continue
+		}
+		valid = append(valid, row)
+	}
+	return valid, nil
+}
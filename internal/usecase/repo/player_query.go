@@ -0,0 +1,109 @@
+package repo
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/arsnazarenko/devops-basketball/internal/apperrors"
+	"github.com/arsnazarenko/devops-basketball/internal/usecase"
+)
+
+// playerSortColumns whitelists the columns a player listing can be sorted by.
+var playerSortColumns = map[usecase.PlayerSortField]string{
+	usecase.PlayerSortID:          "id",
+	usecase.PlayerSortName:        "name",
+	usecase.PlayerSortSurname:     "surname",
+	usecase.PlayerSortAge:         "age",
+	usecase.PlayerSortHeight:      "height",
+	usecase.PlayerSortWeight:      "weight",
+	usecase.PlayerSortCitizenship: "citizenship",
+	usecase.PlayerSortRole:        "role",
+	usecase.PlayerSortTeamID:      "team_id",
+}
+
+// queryArgs collects positional query arguments.
+type queryArgs []any
+
+// add appends v and returns its placeholder.
+func (a *queryArgs) add(v any) string {
+	*a = append(*a, v)
+	return "$" + strconv.Itoa(len(*a))
+}
+
+// playerConditions returns the WHERE conditions for filter.
+func playerConditions(filter usecase.PlayerFilter, args *queryArgs) []string {
+	var conds []string
+	if filter.TeamID != nil {
+		conds = append(conds, "team_id = "+args.add(*filter.TeamID))
+	}
+	if filter.Role != nil {
+		conds = append(conds, "role = "+args.add(*filter.Role))
+	}
+	if filter.Citizenship != nil {
+		conds = append(conds, "citizenship = "+args.add(*filter.Citizenship))
+	}
+	if filter.MinAge != nil {
+		conds = append(conds, "age >= "+args.add(*filter.MinAge))
+	}
+	if filter.MaxAge != nil {
+		conds = append(conds, "age <= "+args.add(*filter.MaxAge))
+	}
+	if filter.MinHeight != nil {
+		conds = append(conds, "height >= "+args.add(*filter.MinHeight))
+	}
+	if filter.MaxHeight != nil {
+		conds = append(conds, "height <= "+args.add(*filter.MaxHeight))
+	}
+	if filter.MinWeight != nil {
+		conds = append(conds, "weight >= "+args.add(*filter.MinWeight))
+	}
+	if filter.MaxWeight != nil {
+		conds = append(conds, "weight <= "+args.add(*filter.MaxWeight))
+	}
+	if !filter.IncludeDeleted {
+		conds = append(conds, "deleted_at IS NULL")
+	}
+	return conds
+}
+
+// whereClause joins conds into a WHERE clause, or returns "" when there are none.
+func whereClause(conds []string) string {
+	if len(conds) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(conds, " AND ")
+}
+
+// normalizePlayerSort validates sort against the whitelist, drops repeated fields
+// and appends id, so that the resulting order is total and pages are stable.
+func normalizePlayerSort(sort []usecase.PlayerSort) ([]usecase.PlayerSort, error) {
+	seen := make(map[usecase.PlayerSortField]bool, len(sort)+1)
+	keys := make([]usecase.PlayerSort, 0, len(sort)+1)
+	for _, key := range sort {
+		if _, ok := playerSortColumns[key.Field]; !ok {
+			return nil, apperrors.ErrInvalidPlayerSort
+		}
+		if seen[key.Field] {
+			continue
+		}
+		seen[key.Field] = true
+		keys = append(keys, key)
+	}
+	if !seen[usecase.PlayerSortID] {
+		keys = append(keys, usecase.PlayerSort{Field: usecase.PlayerSortID})
+	}
+	return keys, nil
+}
+
+// orderByClause builds the ORDER BY clause for normalized sort keys.
+func orderByClause(keys []usecase.PlayerSort) string {
+	terms := make([]string, 0, len(keys))
+	for _, key := range keys {
+		term := playerSortColumns[key.Field]
+		if key.Desc {
+			term += " DESC"
+		}
+		terms = append(terms, term)
+	}
+	return " ORDER BY " + strings.Join(terms, ", ")
+}
@@ -0,0 +1,143 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/arsnazarenko/devops-basketball/api/gen"
+	"github.com/arsnazarenko/devops-basketball/internal/apperrors"
+	"github.com/arsnazarenko/devops-basketball/internal/usecase"
+	"github.com/arsnazarenko/devops-basketball/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+var _ usecase.TeamRp = (*TeamRepo)(nil)
+
+type TeamRepo struct {
+	pg *postgres.Postgres
+}
+
+func NewTeamRepo(pg *postgres.Postgres) *TeamRepo {
+	return &TeamRepo{
+		pg: pg,
+	}
+}
+
+// CreateTeam implements usecase.TeamRp.
+func (t *TeamRepo) CreateTeam(ctx context.Context, team *gen.TeamCreate) (*gen.Team, error) {
+	query := "INSERT INTO teams (name, city) VALUES ($1, $2) RETURNING id"
+	var id int64
+	if err := t.pg.Pool.QueryRow(ctx, query, team.Name, team.City).Scan(&id); err != nil {
+		return nil, fmt.Errorf("repo.CreateTeam: create team error: %w", constraintError(err))
+	}
+
+	return &gen.Team{
+		Id:   id,
+		Name: team.Name,
+		City: team.City,
+	}, nil
+}
+
+// DeleteTeam implements usecase.TeamRp.
+// Deleted players of the team are purged with it, they cannot be restored without the team.
+func (t *TeamRepo) DeleteTeam(ctx context.Context, teamID int64) error {
+	purge := "DELETE FROM players WHERE team_id = $1 AND deleted_at IS NOT NULL"
+	query := "DELETE FROM teams WHERE id = $1"
+	err := pgx.BeginFunc(ctx, t.pg.Pool, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, purge, teamID); err != nil {
+			return fmt.Errorf("purge deleted players error: %w", err)
+		}
+		res, err := tx.Exec(ctx, query, teamID)
+		if isForeignKeyViolation(err, playersTeamIDFkey) {
+			return apperrors.ErrTeamHasPlayers
+		}
+		if isForeignKeyViolation(err, gamesHomeTeamIDFkey) || isForeignKeyViolation(err, gamesAwayTeamIDFkey) {
+			return apperrors.ErrTeamHasGames
+		}
+		if err != nil {
+			return fmt.Errorf("delete team error: %w", constraintError(err))
+		}
+		if res.RowsAffected() == 0 {
+			return apperrors.ErrTeamNotFound
+		}
+		return nil
+	})
+	if errors.Is(err, apperrors.ErrTeamHasPlayers) || errors.Is(err, apperrors.ErrTeamHasGames) ||
+		errors.Is(err, apperrors.ErrTeamNotFound) {
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("repo.DeleteTeam: %w", err)
+	}
+	return nil
+}
+
+// GetTeam implements usecase.TeamRp.
+func (t *TeamRepo) GetTeam(ctx context.Context, teamID int64) (*gen.Team, error) {
+	query := "SELECT id, name, city FROM teams WHERE id = $1"
+
+	var team gen.Team
+	if err := t.pg.Pool.QueryRow(ctx, query, teamID).Scan(
+		&team.Id,
+		&team.Name,
+		&team.City,
+	); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrTeamNotFound
+		}
+		return nil, fmt.Errorf("repo.GetTeam: error: %w", err)
+	}
+	return &team, nil
+}
+
+// GetTeamList implements usecase.TeamRp.
+func (t *TeamRepo) GetTeamList(ctx context.Context, pageSize uint64, pageNumber uint64) ([]gen.Team, error) {
+	if pageNumber < 1 {
+		return nil, apperrors.ErrInvalidTeamPageNumber
+	}
+	if pageSize < 1 {
+		return nil, apperrors.ErrInvalidTeamPageSize
+	}
+	limit, offset := pageSize, (pageNumber-1)*pageSize
+	query := "SELECT id, name, city FROM teams ORDER BY id LIMIT $1 OFFSET $2"
+	rows, err := t.pg.Pool.Query(ctx, query, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetTeamList: error: %w", err)
+	}
+	defer rows.Close()
+	list := []gen.Team{}
+	for rows.Next() {
+		var team gen.Team
+		if err := rows.Scan(
+			&team.Id,
+			&team.Name,
+			&team.City,
+		); err != nil {
+			return nil, fmt.Errorf("repo.GetTeamList: error: %w", err)
+		}
+		list = append(list, team)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("repo.GetTeamList: error: %w", err)
+	}
+	return list, nil
+}
+
+// UpdateTeam implements usecase.TeamRp.
+func (t *TeamRepo) UpdateTeam(ctx context.Context, teamID int64, team *gen.TeamUpdate) (*gen.Team, error) {
+	query := "UPDATE teams SET name = $1, city = $2 WHERE id = $3 RETURNING id, name, city"
+
+	var updated gen.Team
+	if err := t.pg.Pool.QueryRow(ctx, query, team.Name, team.City, teamID).Scan(
+		&updated.Id,
+		&updated.Name,
+		&updated.City,
+	); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrTeamNotFound
+		}
+		return nil, fmt.Errorf("repo.UpdateTeam: error: %w", constraintError(err))
+	}
+	return &updated, nil
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/arsnazarenko/devops-basketball/api/gen"
+)
+
+type TeamUC struct {
+	r TeamRp
+}
+
+func NewTeamUsecase(repo TeamRp) *TeamUC {
+	return &TeamUC{
+		r: repo,
+	}
+}
+
+var _ Team = (*TeamUC)(nil)
+
+// CreateTeam implements Team.
+func (t *TeamUC) CreateTeam(ctx context.Context, team *gen.TeamCreate) (*gen.Team, error) {
+	return t.r.CreateTeam(ctx, team)
+}
+
+// DeleteTeam implements Team.
+func (t *TeamUC) DeleteTeam(ctx context.Context, teamID int64) error {
+	return t.r.DeleteTeam(ctx, teamID)
+}
+
+// GetTeam implements Team.
+func (t *TeamUC) GetTeam(ctx context.Context, teamID int64) (*gen.Team, error) {
+	return t.r.GetTeam(ctx, teamID)
+}
+
+// GetTeamList implements Team.
+func (t *TeamUC) GetTeamList(ctx context.Context, pageSize uint64, pageNumber uint64) ([]gen.Team, error) {
+	return t.r.GetTeamList(ctx, pageSize, pageNumber)
+}
+
+// UpdateTeam implements Team.
+func (t *TeamUC) UpdateTeam(ctx context.Context, teamID int64, team *gen.TeamUpdate) (*gen.Team, error) {
+	return t.r.UpdateTeam(ctx, teamID, team)
+}
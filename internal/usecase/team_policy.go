@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/arsnazarenko/devops-basketball/api/gen"
+)
+
+// TeamPolicy wraps a Team use case and authorizes every call by the role of
+// the auth.Principal in the context: every known role can read the teams,
+// only auth.RoleLeagueAdmin can create, update and delete them.
+//
+// Calls without a principal are denied with apperrors.ErrForbidden.
+type TeamPolicy struct {
+	next Team
+}
+
+func NewTeamPolicy(next Team) *TeamPolicy {
+	return &TeamPolicy{
+		next: next,
+	}
+}
+
+var _ Team = (*TeamPolicy)(nil)
+
+// CreateTeam implements Team.
+func (t *TeamPolicy) CreateTeam(ctx context.Context, team *gen.TeamCreate) (*gen.Team, error) {
+	if err := authorizeLeagueAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return t.next.CreateTeam(ctx, team)
+}
+
+// UpdateTeam implements Team.
+func (t *TeamPolicy) UpdateTeam(ctx context.Context, teamID int64, team *gen.TeamUpdate) (*gen.Team, error) {
+	if err := authorizeLeagueAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return t.next.UpdateTeam(ctx, teamID, team)
+}
+
+// DeleteTeam implements Team.
+func (t *TeamPolicy) DeleteTeam(ctx context.Context, teamID int64) error {
+	if err := authorizeLeagueAdmin(ctx); err != nil {
+		return err
+	}
+	return t.next.DeleteTeam(ctx, teamID)
+}
+
+// GetTeam implements Team.
+func (t *TeamPolicy) GetTeam(ctx context.Context, teamID int64) (*gen.Team, error) {
+	if err := authorizeRead(ctx); err != nil {
+		return nil, err
+	}
+	return t.next.GetTeam(ctx, teamID)
+}
+
+// GetTeamList implements Team.
+func (t *TeamPolicy) GetTeamList(ctx context.Context, pageSize, pageNumber uint64) ([]gen.Team, error) {
+	if err := authorizeRead(ctx); err != nil {
+		return nil, err
+	}
+	return t.next.GetTeamList(ctx, pageSize, pageNumber)
+}
@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/arsnazarenko/devops-basketball/api/gen"
+	"github.com/arsnazarenko/devops-basketball/internal/apperrors"
+	"github.com/arsnazarenko/devops-basketball/internal/auth"
+	"github.com/stretchr/testify/assert"
+)
+
+// stubTeamRepo accepts every change and lists no teams.
+type stubTeamRepo struct {
+	TeamRp
+}
+
+func (stubTeamRepo) CreateTeam(_ context.Context, team *gen.TeamCreate) (*gen.Team, error) {
+	return &gen.Team{Name: team.Name}, nil
+}
+
+func (stubTeamRepo) UpdateTeam(_ context.Context, teamID int64, team *gen.TeamUpdate) (*gen.Team, error) {
+	return &gen.Team{Id: teamID, Name: team.Name}, nil
+}
+
+func (stubTeamRepo) DeleteTeam(context.Context, int64) error {
+	return nil
+}
+
+func (stubTeamRepo) GetTeamList(context.Context, uint64, uint64) ([]gen.Team, error) {
+	return []gen.Team{}, nil
+}
+
+func TestTeamPolicy(t *testing.T) {
+	policy := NewTeamPolicy(NewTeamUsecase(stubTeamRepo{}))
+	team := int64(1)
+
+	as := func(role auth.Role, teamID *int64) context.Context {
+		return auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "test", Role: role, TeamID: teamID})
+	}
+	create := func(ctx context.Context) error {
+		_, err := policy.CreateTeam(ctx, &gen.TeamCreate{Name: "Bulls"})
+		return err
+	}
+	update := func(ctx context.Context) error {
+		_, err := policy.UpdateTeam(ctx, team, &gen.TeamUpdate{Name: "Bulls"})
+		return err
+	}
+	list := func(ctx context.Context) error {
+		_, err := policy.GetTeamList(ctx, 20, 1)
+		return err
+	}
+
+	cases := []struct {
+		name    string
+		err     error
+		allowed bool
+	}{
+		{"anonymous cannot list", list(context.Background()), false},
+		{"scout can list", list(as(auth.RoleScout, nil)), true},
+		{"scout cannot create", create(as(auth.RoleScout, nil)), false},
+		{"manager cannot create", create(as(auth.RoleTeamManager, &team)), false},
+		{"manager cannot update own team", update(as(auth.RoleTeamManager, &team)), false},
+		{"manager cannot delete own team", policy.DeleteTeam(as(auth.RoleTeamManager, &team), team), false},
+		{"admin creates", create(as(auth.RoleLeagueAdmin, nil)), true},
+		{"admin updates", update(as(auth.RoleLeagueAdmin, nil)), true},
+		{"admin deletes", policy.DeleteTeam(as(auth.RoleLeagueAdmin, nil), team), true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.allowed {
+				assert.NoError(t, tc.err)
+			} else {
+				assert.ErrorIs(t, tc.err, apperrors.ErrForbidden)
+			}
+		})
+	}
+}
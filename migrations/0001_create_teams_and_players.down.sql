@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS players;
+DROP TABLE IF EXISTS teams;
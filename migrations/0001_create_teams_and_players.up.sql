@@ -1,3 +1,9 @@
+CREATE TABLE IF NOT EXISTS teams (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL CHECK (LENGTH(name) >= 1),
+    city VARCHAR(50) NOT NULL CHECK (LENGTH(city) >= 1)
+);
+
 CREATE TABLE IF NOT EXISTS players (
     id BIGSERIAL PRIMARY KEY,
     name VARCHAR(50) NOT NULL CHECK (LENGTH(name) >= 1),
@@ -7,5 +13,8 @@ CREATE TABLE IF NOT EXISTS players (
     weight INTEGER NOT NULL CHECK (weight >= 50000),
     citizenship VARCHAR(10) NOT NULL CHECK (LENGTH(citizenship) >= 2),
     role VARCHAR(2) NOT NULL CHECK (role IN ('PG', 'SG', 'SF', 'PF', 'C')),
-    team_id BIGINT NOT NULL CHECK (team_id >= 1)
+    team_id BIGINT NOT NULL CHECK (team_id >= 1),
+    CONSTRAINT players_team_id_fkey FOREIGN KEY (team_id) REFERENCES teams (id) ON DELETE RESTRICT
 );
+
+CREATE INDEX IF NOT EXISTS players_team_id_idx ON players (team_id);
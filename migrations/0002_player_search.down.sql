@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS players_full_name_trgm_idx;
+DROP INDEX IF EXISTS players_search_vector_idx;
+
+ALTER TABLE players DROP COLUMN IF EXISTS search_vector;
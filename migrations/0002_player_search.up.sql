@@ -0,0 +1,8 @@
+-- player search: full-text over name and surname plus trigram similarity for fuzzy spellings
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE players ADD COLUMN IF NOT EXISTS search_vector tsvector
+    GENERATED ALWAYS AS (to_tsvector('simple', name || ' ' || surname)) STORED;
+
+CREATE INDEX IF NOT EXISTS players_search_vector_idx ON players USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS players_full_name_trgm_idx ON players USING GIN ((name || ' ' || surname) gin_trgm_ops);
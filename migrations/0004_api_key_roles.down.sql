@@ -0,0 +1,4 @@
+ALTER TABLE api_keys
+    DROP CONSTRAINT IF EXISTS api_keys_team_manager_team_check,
+    DROP COLUMN IF EXISTS team_id,
+    DROP COLUMN IF EXISTS role;
@@ -0,0 +1,5 @@
+ALTER TABLE api_keys
+    ADD COLUMN role VARCHAR(20) NOT NULL DEFAULT 'scout'
+        CHECK (role IN ('scout', 'team_manager', 'league_admin')),
+    ADD COLUMN team_id BIGINT REFERENCES teams (id) ON DELETE CASCADE,
+    ADD CONSTRAINT api_keys_team_manager_team_check CHECK (role <> 'team_manager' OR team_id IS NOT NULL);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS player_audit;
+DROP FUNCTION IF EXISTS player_audit_append_only();
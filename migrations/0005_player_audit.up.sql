@@ -0,0 +1,26 @@
+CREATE TABLE player_audit (
+    id         BIGSERIAL PRIMARY KEY,
+    -- no foreign key, the history outlives the player
+    player_id  BIGINT      NOT NULL,
+    actor      TEXT        NOT NULL,
+    operation  VARCHAR(10) NOT NULL CHECK (operation IN ('create', 'update', 'delete')),
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    before     JSONB,
+    after      JSONB
+);
+
+CREATE INDEX player_audit_player_id_idx ON player_audit (player_id, id);
+
+CREATE FUNCTION player_audit_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'player_audit is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER player_audit_append_only
+    BEFORE UPDATE OR DELETE ON player_audit
+    FOR EACH ROW EXECUTE FUNCTION player_audit_append_only();
+
+CREATE TRIGGER player_audit_no_truncate
+    BEFORE TRUNCATE ON player_audit
+    FOR EACH STATEMENT EXECUTE FUNCTION player_audit_append_only();
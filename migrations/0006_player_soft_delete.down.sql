@@ -0,0 +1,13 @@
+-- restores are not representable anymore, nor are players kept for restoring
+DELETE FROM players WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE player_audit
+    DISABLE TRIGGER player_audit_append_only;
+DELETE FROM player_audit WHERE operation = 'restore';
+ALTER TABLE player_audit
+    ENABLE TRIGGER player_audit_append_only,
+    DROP CONSTRAINT player_audit_operation_check,
+    ADD CONSTRAINT player_audit_operation_check CHECK (operation IN ('create', 'update', 'delete'));
+
+DROP INDEX IF EXISTS players_deleted_at_idx;
+ALTER TABLE players DROP COLUMN IF EXISTS deleted_at;
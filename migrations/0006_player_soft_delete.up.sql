@@ -0,0 +1,8 @@
+ALTER TABLE players ADD COLUMN deleted_at TIMESTAMPTZ;
+
+-- finds the tombstones to purge
+CREATE INDEX players_deleted_at_idx ON players (deleted_at) WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE player_audit
+    DROP CONSTRAINT player_audit_operation_check,
+    ADD CONSTRAINT player_audit_operation_check CHECK (operation IN ('create', 'update', 'delete', 'restore'));
@@ -0,0 +1,15 @@
+CREATE TABLE idempotency_keys (
+    -- the caller that sent the key, keys of different callers never collide
+    scope        TEXT        NOT NULL,
+    key          TEXT        NOT NULL,
+    request_hash BYTEA       NOT NULL,
+    -- status, headers and body are NULL while the request is in progress
+    status       INTEGER,
+    headers      JSONB,
+    body         BYTEA,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+    expires_at   TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (scope, key)
+);
+
+CREATE INDEX idempotency_keys_expires_at_idx ON idempotency_keys (expires_at);
@@ -0,0 +1,24 @@
+CREATE TABLE games (
+    id BIGSERIAL PRIMARY KEY,
+    home_team_id BIGINT NOT NULL,
+    away_team_id BIGINT NOT NULL,
+    scheduled_at TIMESTAMPTZ NOT NULL,
+    venue VARCHAR(100) NOT NULL CHECK (LENGTH(venue) >= 1),
+    status VARCHAR(10) NOT NULL DEFAULT 'scheduled' CHECK (status IN ('scheduled', 'live', 'final', 'postponed')),
+    home_score INTEGER CHECK (home_score >= 0),
+    away_score INTEGER CHECK (away_score >= 0),
+    CONSTRAINT games_home_team_id_fkey FOREIGN KEY (home_team_id) REFERENCES teams (id) ON DELETE RESTRICT,
+    CONSTRAINT games_away_team_id_fkey FOREIGN KEY (away_team_id) REFERENCES teams (id) ON DELETE RESTRICT,
+    CONSTRAINT games_teams_check CHECK (home_team_id <> away_team_id),
+    -- live games may have a score, final games must have one, the others have none
+    CONSTRAINT games_score_check CHECK (
+        (home_score IS NULL) = (away_score IS NULL)
+        AND (status <> 'final' OR home_score IS NOT NULL)
+        AND (status IN ('live', 'final') OR home_score IS NULL)
+    )
+);
+
+-- the schedule of a team is queried by either side
+CREATE INDEX games_home_team_id_scheduled_at_idx ON games (home_team_id, scheduled_at);
+CREATE INDEX games_away_team_id_scheduled_at_idx ON games (away_team_id, scheduled_at);
+CREATE INDEX games_scheduled_at_idx ON games (scheduled_at);
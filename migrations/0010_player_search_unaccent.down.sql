@@ -0,0 +1,12 @@
+DROP INDEX IF EXISTS players_full_name_trgm_idx;
+DROP INDEX IF EXISTS players_search_vector_idx;
+ALTER TABLE players DROP COLUMN IF EXISTS search_vector;
+
+ALTER TABLE players ADD COLUMN search_vector tsvector
+    GENERATED ALWAYS AS (to_tsvector('simple', name || ' ' || surname)) STORED;
+
+CREATE INDEX players_search_vector_idx ON players USING GIN (search_vector);
+CREATE INDEX players_full_name_trgm_idx ON players USING GIN ((name || ' ' || surname) gin_trgm_ops);
+
+DROP FUNCTION IF EXISTS f_unaccent(text);
+DROP EXTENSION IF EXISTS unaccent;
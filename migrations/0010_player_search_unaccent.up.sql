@@ -0,0 +1,18 @@
+-- diacritic-insensitive player search, so that "Doncic" finds "Dončić"
+CREATE EXTENSION IF NOT EXISTS unaccent;
+
+-- unaccent is only STABLE because its dictionary can change; the wrapper pins the
+-- dictionary so that it can be used in indexes and generated columns
+CREATE OR REPLACE FUNCTION f_unaccent(text) RETURNS text
+    LANGUAGE sql IMMUTABLE PARALLEL SAFE STRICT
+    RETURN public.unaccent('public.unaccent'::regdictionary, $1);
+
+DROP INDEX IF EXISTS players_full_name_trgm_idx;
+DROP INDEX IF EXISTS players_search_vector_idx;
+ALTER TABLE players DROP COLUMN IF EXISTS search_vector;
+
+ALTER TABLE players ADD COLUMN search_vector tsvector
+    GENERATED ALWAYS AS (to_tsvector('simple', f_unaccent(name || ' ' || surname))) STORED;
+
+CREATE INDEX players_search_vector_idx ON players USING GIN (search_vector);
+CREATE INDEX players_full_name_trgm_idx ON players USING GIN (f_unaccent(name || ' ' || surname) gin_trgm_ops);
@@ -0,0 +1,10 @@
+// Package migrations embeds the versioned database schema migrations.
+//
+// Each version is a pair of files named NNNN_description.up.sql and
+// NNNN_description.down.sql, applied in ascending order of NNNN.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
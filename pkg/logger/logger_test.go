@@ -0,0 +1,37 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("request id from context", func(t *testing.T) {
+		var buf bytes.Buffer
+		l, err := New(&buf, "info", FormatJSON)
+		require.NoError(t, err)
+
+		l.With("component", "repo").InfoContext(WithRequestID(context.Background(), "abc"), "hello")
+		l.DebugContext(context.Background(), "hidden")
+
+		var record map[string]any
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+		require.Equal(t, "hello", record["msg"])
+		require.Equal(t, "abc", record["request_id"])
+		require.Equal(t, "repo", record["component"])
+	})
+
+	t.Run("invalid level", func(t *testing.T) {
+		_, err := New(&bytes.Buffer{}, "verbose", FormatJSON)
+		require.Error(t, err)
+	})
+
+	t.Run("invalid format", func(t *testing.T) {
+		_, err := New(&bytes.Buffer{}, "info", "xml")
+		require.Error(t, err)
+	})
+}
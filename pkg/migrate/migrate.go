@@ -0,0 +1,238 @@
+// Package migrate applies versioned SQL migrations to PostgreSQL
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	// _lockKey identifies the advisory lock held while migrating, so that
+	// several instances starting at once do not migrate concurrently.
+	_lockKey = 7_262_018_400_911
+
+	_createTable = `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version BIGINT PRIMARY KEY,
+		name TEXT NOT NULL,
+		applied_at TIMESTAMPTZ NOT NULL DEFAULT now()
+	)`
+)
+
+var ErrUnknownVersion = errors.New("unknown migration version")
+
+// Status describes a known migration and whether it is applied.
+type Status struct {
+	Version   uint
+	Name      string
+	AppliedAt *time.Time
+}
+
+type Migrator struct {
+	pool       *pgxpool.Pool
+	migrations []Migration
+}
+
+// New creates a Migrator for the migrations stored in fsys.
+func New(pool *pgxpool.Pool, fsys fs.FS) (*Migrator, error) {
+	migrations, err := Load(fsys)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{
+		pool:       pool,
+		migrations: migrations,
+	}, nil
+}
+
+// Latest returns the highest known version, or 0 when there are no migrations.
+func (m *Migrator) Latest() uint {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// Up applies all pending migrations.
+func (m *Migrator) Up(ctx context.Context) error {
+	return m.To(ctx, m.Latest())
+}
+
+// Down reverts the latest applied migration.
+func (m *Migrator) Down(ctx context.Context) error {
+	return m.withLock(ctx, func(conn *pgx.Conn) error {
+		current, err := currentVersion(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if current == 0 {
+			return nil
+		}
+		var target uint
+		for _, migration := range m.migrations {
+			if migration.Version < current {
+				target = migration.Version
+			}
+		}
+		return m.migrate(ctx, conn, current, target)
+	})
+}
+
+// To migrates up or down until version is the latest applied one.
+// Version 0 reverts all migrations.
+func (m *Migrator) To(ctx context.Context, version uint) error {
+	if version != 0 && m.find(version) < 0 {
+		return fmt.Errorf("migrate.To: %w: %d", ErrUnknownVersion, version)
+	}
+	return m.withLock(ctx, func(conn *pgx.Conn) error {
+		current, err := currentVersion(ctx, conn)
+		if err != nil {
+			return err
+		}
+		return m.migrate(ctx, conn, current, version)
+	})
+}
+
+// Version returns the latest applied version, or 0 when nothing is applied.
+// It only reads the database, so it is cheap enough for readiness probes.
+func (m *Migrator) Version(ctx context.Context) (uint, error) {
+	var exists bool
+	if err := m.pool.QueryRow(ctx, "SELECT to_regclass('schema_migrations') IS NOT NULL").Scan(&exists); err != nil {
+		return 0, fmt.Errorf("migrate.Version: %w", err)
+	}
+	if !exists {
+		return 0, nil
+	}
+	var version int64
+	if err := m.pool.QueryRow(ctx, "SELECT COALESCE(MAX(version), 0) FROM schema_migrations").Scan(&version); err != nil {
+		return 0, fmt.Errorf("migrate.Version: %w", err)
+	}
+	return uint(version), nil
+}
+
+// Status lists all known migrations with the time they were applied.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	if _, err := m.pool.Exec(ctx, _createTable); err != nil {
+		return nil, fmt.Errorf("migrate.Status: %w", err)
+	}
+	rows, err := m.pool.Query(ctx, "SELECT version, applied_at FROM schema_migrations")
+	if err != nil {
+		return nil, fmt.Errorf("migrate.Status: %w", err)
+	}
+	defer rows.Close()
+	applied := make(map[uint]time.Time)
+	for rows.Next() {
+		var (
+			version   int64
+			appliedAt time.Time
+		)
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, fmt.Errorf("migrate.Status: %w", err)
+		}
+		applied[uint(version)] = appliedAt
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("migrate.Status: %w", err)
+	}
+
+	statuses := make([]Status, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		status := Status{Version: migration.Version, Name: migration.Name}
+		if at, ok := applied[migration.Version]; ok {
+			status.AppliedAt = &at
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// migrate applies the migrations in (current, target] or reverts those in (target, current].
+func (m *Migrator) migrate(ctx context.Context, conn *pgx.Conn, current, target uint) error {
+	if target >= current {
+		for _, migration := range m.migrations {
+			if migration.Version <= current || migration.Version > target {
+				continue
+			}
+			if err := apply(ctx, conn, migration.Up,
+				"INSERT INTO schema_migrations (version, name) VALUES ($1, $2)", int64(migration.Version), migration.Name); err != nil {
+				return fmt.Errorf("migrate: up %d_%s: %w", migration.Version, migration.Name, err)
+			}
+		}
+		return nil
+	}
+
+	for i := len(m.migrations) - 1; i >= 0; i-- {
+		migration := m.migrations[i]
+		if migration.Version > current || migration.Version <= target {
+			continue
+		}
+		if err := apply(ctx, conn, migration.Down,
+			"DELETE FROM schema_migrations WHERE version = $1", int64(migration.Version)); err != nil {
+			return fmt.Errorf("migrate: down %d_%s: %w", migration.Version, migration.Name, err)
+		}
+	}
+	return nil
+}
+
+// apply runs a migration script and its bookkeeping statement in one transaction.
+// The script is not limited by the statement_timeout of the pool.
+func apply(ctx context.Context, conn *pgx.Conn, script, bookkeeping string, args ...any) error {
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck // no-op after commit
+
+	if _, err := tx.Exec(ctx, "SET LOCAL statement_timeout = 0"); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, script); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(ctx, bookkeeping, args...); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// withLock runs fn on a dedicated connection holding the migration advisory lock.
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *pgx.Conn) error) error {
+	conn, err := m.pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("migrate: acquire connection: %w", err)
+	}
+	defer conn.Release()
+
+	if _, err := conn.Exec(ctx, "SELECT pg_advisory_lock($1)", int64(_lockKey)); err != nil {
+		return fmt.Errorf("migrate: acquire lock: %w", err)
+	}
+	defer conn.Exec(context.Background(), "SELECT pg_advisory_unlock($1)", int64(_lockKey)) //nolint:errcheck // released with the session anyway
+
+	if _, err := conn.Exec(ctx, _createTable); err != nil {
+		return fmt.Errorf("migrate: create schema table: %w", err)
+	}
+	return fn(conn.Conn())
+}
+
+func (m *Migrator) find(version uint) int {
+	for i, migration := range m.migrations {
+		if migration.Version == version {
+			return i
+		}
+	}
+	return -1
+}
+
+func currentVersion(ctx context.Context, conn *pgx.Conn) (uint, error) {
+	var version int64
+	if err := conn.QueryRow(ctx, "SELECT COALESCE(MAX(version), 0) FROM schema_migrations").Scan(&version); err != nil {
+		return 0, fmt.Errorf("migrate: current version: %w", err)
+	}
+	return uint(version), nil
+}
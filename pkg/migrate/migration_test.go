@@ -0,0 +1,46 @@
+package migrate
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	t.Run("sorted by version", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"0010_add_index.up.sql":      {Data: []byte("CREATE INDEX")},
+			"0010_add_index.down.sql":    {Data: []byte("DROP INDEX")},
+			"0002_create_table.up.sql":   {Data: []byte("CREATE TABLE")},
+			"0002_create_table.down.sql": {Data: []byte("DROP TABLE")},
+			"migrations.go":              {Data: []byte("package migrations")},
+		}
+
+		migrations, err := Load(fsys)
+		require.NoError(t, err)
+		require.Equal(t, []Migration{
+			{Version: 2, Name: "create_table", Up: "CREATE TABLE", Down: "DROP TABLE"},
+			{Version: 10, Name: "add_index", Up: "CREATE INDEX", Down: "DROP INDEX"},
+		}, migrations)
+	})
+
+	t.Run("missing down file", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"0001_create_table.up.sql": {Data: []byte("CREATE TABLE")},
+		}
+
+		_, err := Load(fsys)
+		require.Error(t, err)
+	})
+
+	t.Run("names differ", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"0001_create_table.up.sql":    {Data: []byte("CREATE TABLE")},
+			"0001_create_tables.down.sql": {Data: []byte("DROP TABLE")},
+		}
+
+		_, err := Load(fsys)
+		require.Error(t, err)
+	})
+}
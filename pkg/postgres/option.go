@@ -10,8 +10,69 @@ func MaxPoolSize(size int) Option {
 	}
 }
 
+// MinPoolSize keeps at least size connections open.
+func MinPoolSize(size int) Option {
+	return func(c *Postgres) {
+		c.minPoolSize = size
+	}
+}
+
+// ConnTimeout bounds establishing a connection and the initial ping.
 func ConnTimeout(timeout time.Duration) Option {
 	return func(c *Postgres) {
 		c.connTimeout = timeout
 	}
 }
+
+// MaxConnLifetime closes connections older than d.
+func MaxConnLifetime(d time.Duration) Option {
+	return func(c *Postgres) {
+		c.maxConnLifetime = d
+	}
+}
+
+// MaxConnIdleTime closes connections idle for longer than d.
+func MaxConnIdleTime(d time.Duration) Option {
+	return func(c *Postgres) {
+		c.maxConnIdleTime = d
+	}
+}
+
+// HealthCheckPeriod sets how often idle connections are checked.
+func HealthCheckPeriod(d time.Duration) Option {
+	return func(c *Postgres) {
+		c.healthCheckPeriod = d
+	}
+}
+
+// StatementTimeout makes the server abort statements running longer than d.
+// Zero disables the timeout.
+func StatementTimeout(d time.Duration) Option {
+	return func(c *Postgres) {
+		c.statementTimeout = d
+	}
+}
+
+// ApplicationName is reported to the server, e.g. in pg_stat_activity.
+func ApplicationName(name string) Option {
+	return func(c *Postgres) {
+		c.applicationName = name
+	}
+}
+
+// ConnAttempts retries the initial connection up to attempts times, doubling
+// the delay between attempts from backoff up to maxBackoff.
+func ConnAttempts(attempts int, backoff, maxBackoff time.Duration) Option {
+	return func(c *Postgres) {
+		c.connAttempts = attempts
+		c.connBackoff = backoff
+		c.connMaxBackoff = maxBackoff
+	}
+}
+
+// Tracing records an OpenTelemetry span for every query.
+func Tracing() Option {
+	return func(c *Postgres) {
+		c.tracing = true
+	}
+}
@@ -4,26 +4,44 @@ package postgres
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"strconv"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 const (
-	_defaultMaxPoolSize = 1
-	_defaultConnTimeout = time.Second
+	_defaultMaxPoolSize    = 1
+	_defaultConnTimeout    = time.Second
+	_defaultConnAttempts   = 1
+	_defaultConnBackoff    = time.Second
+	_defaultConnMaxBackoff = 10 * time.Second
 )
 
 type Postgres struct {
-	maxPoolSize int
-	connTimeout time.Duration
-	Pool        *pgxpool.Pool
+	maxPoolSize       int
+	minPoolSize       int
+	connTimeout       time.Duration
+	maxConnLifetime   time.Duration
+	maxConnIdleTime   time.Duration
+	healthCheckPeriod time.Duration
+	statementTimeout  time.Duration
+	applicationName   string
+	connAttempts      int
+	connBackoff       time.Duration
+	connMaxBackoff    time.Duration
+	tracing           bool
+	Pool              *pgxpool.Pool
 }
 
 func New(url string, opts ...Option) (*Postgres, error) {
 	pg := &Postgres{
-		maxPoolSize: _defaultMaxPoolSize,
-		connTimeout: _defaultConnTimeout,
+		maxPoolSize:    _defaultMaxPoolSize,
+		connTimeout:    _defaultConnTimeout,
+		connAttempts:   _defaultConnAttempts,
+		connBackoff:    _defaultConnBackoff,
+		connMaxBackoff: _defaultConnMaxBackoff,
 	}
 
 	// Custom options
@@ -37,25 +55,61 @@ func New(url string, opts ...Option) (*Postgres, error) {
 	}
 
 	poolConfig.MaxConns = int32(pg.maxPoolSize)
+	poolConfig.MinConns = int32(pg.minPoolSize)
+	poolConfig.ConnConfig.ConnectTimeout = pg.connTimeout
+	if pg.maxConnLifetime > 0 {
+		poolConfig.MaxConnLifetime = pg.maxConnLifetime
+	}
+	if pg.maxConnIdleTime > 0 {
+		poolConfig.MaxConnIdleTime = pg.maxConnIdleTime
+	}
+	if pg.healthCheckPeriod > 0 {
+		poolConfig.HealthCheckPeriod = pg.healthCheckPeriod
+	}
+	if pg.statementTimeout > 0 {
+		poolConfig.ConnConfig.RuntimeParams["statement_timeout"] = strconv.FormatInt(pg.statementTimeout.Milliseconds(), 10)
+	}
+	if pg.applicationName != "" {
+		poolConfig.ConnConfig.RuntimeParams["application_name"] = pg.applicationName
+	}
+	if pg.tracing {
+		poolConfig.ConnConfig.Tracer = newQueryTracer()
+	}
 
-	// Try to connect immediately to validate connection
 	pg.Pool, err = pgxpool.NewWithConfig(context.Background(), poolConfig)
 	if err != nil {
 		return nil, fmt.Errorf("postgres.NewPostgres.failed to connect: %w", err)
 	}
 
-	// Test the connection
-	ctx, cancel := context.WithTimeout(context.Background(), pg.connTimeout)
-	defer cancel()
-
-	if err = pg.Pool.Ping(ctx); err != nil {
-		pg.Pool.Close()
-		return nil, fmt.Errorf("postgres.NewPostgres.failed to ping: %w", err)
+	// Test the connection, waiting for the server to come up
+	backoff := pg.connBackoff
+	for attempt := 1; ; attempt++ {
+		if err = pg.ping(); err == nil {
+			break
+		}
+		if attempt >= pg.connAttempts {
+			pg.Pool.Close()
+			return nil, fmt.Errorf("postgres.NewPostgres.failed to ping: %w", err)
+		}
+		slog.Warn("postgres is not available, retrying",
+			slog.Int("attempt", attempt),
+			slog.Duration("backoff", backoff),
+			slog.Any("error", err),
+		)
+		time.Sleep(backoff)
+		backoff = min(2*backoff, pg.connMaxBackoff)
 	}
 
 	return pg, nil
 }
 
+func (p *Postgres) ping() error {
+	ctx, cancel := context.WithTimeout(context.Background(), p.connTimeout)
+	defer cancel()
+
+	return p.Pool.Ping(ctx)
+}
+
 func (p *Postgres) Close() {
 	if p.Pool != nil {
 		p.Pool.Close()
@@ -0,0 +1,19 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGivesUpAfterAttempts(t *testing.T) {
+	start := time.Now()
+	_, err := New("postgres://postgres@127.0.0.1:1/postgres",
+		ConnTimeout(100*time.Millisecond),
+		ConnAttempts(3, 10*time.Millisecond, 15*time.Millisecond),
+	)
+	require.Error(t, err)
+	// two sleeps between three attempts: 10ms, then 15ms capped from 20ms
+	require.GreaterOrEqual(t, time.Since(start), 25*time.Millisecond)
+}
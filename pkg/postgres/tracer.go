@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/arsnazarenko/devops-basketball/pkg/postgres"
+
+var _ pgx.QueryTracer = (*queryTracer)(nil)
+
+// queryTracer records a client span for every query with its SQL statement
+// and the number of rows it returned or affected.
+type queryTracer struct {
+	tracer trace.Tracer
+}
+
+func newQueryTracer() *queryTracer {
+	return &queryTracer{tracer: otel.Tracer(tracerName)}
+}
+
+// TraceQueryStart implements pgx.QueryTracer.
+func (t *queryTracer) TraceQueryStart(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	ctx, _ = t.tracer.Start(ctx, "postgres.query",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemNamePostgreSQL,
+			semconv.DBQueryText(data.SQL),
+			semconv.DBNamespace(conn.Config().Database),
+		),
+	)
+	return ctx
+}
+
+// TraceQueryEnd implements pgx.QueryTracer.
+func (t *queryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	if data.Err != nil {
+		span.RecordError(data.Err)
+		span.SetStatus(codes.Error, data.Err.Error())
+		return
+	}
+	span.SetAttributes(
+		attribute.Int64("db.response.returned_rows", data.CommandTag.RowsAffected()),
+		semconv.DBOperationName(commandName(data.CommandTag)),
+	)
+}
+
+// commandName returns the command of a tag like "INSERT 0 1".
+func commandName(tag pgconn.CommandTag) string {
+	name, _, _ := strings.Cut(tag.String(), " ")
+	return name
+}
@@ -0,0 +1,130 @@
+// Package tracing configures OpenTelemetry tracing and W3C trace context propagation
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+)
+
+const (
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+
+	ProtocolGRPC = "grpc"
+	ProtocolHTTP = "http"
+)
+
+// Config describes where spans are exported.
+type Config struct {
+	ServiceName string
+	// Exporter is either otlp or stdout
+	Exporter string
+	// Endpoint is the host:port of the OTLP collector
+	Endpoint string
+	// Protocol of the OTLP exporter, grpc or http
+	Protocol string
+	// Insecure disables TLS for the OTLP exporter
+	Insecure bool
+	// File receives spans of the stdout exporter, stdout when empty
+	File string
+	// SampleRatio is the fraction of new traces that are sampled
+	SampleRatio float64
+}
+
+// Setup installs a global tracer provider exporting spans as configured and
+// the W3C trace context propagator. The returned function flushes pending
+// spans and releases the exporter.
+func Setup(ctx context.Context, cfg Config) (func(context.Context) error, error) {
+	exporter, closer, err := newExporter(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(cfg.ServiceName),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("tracing.Setup: resource: %w", err)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	return func(ctx context.Context) error {
+		err := tp.Shutdown(ctx)
+		if closer != nil {
+			err = errors.Join(err, closer.Close())
+		}
+		return err
+	}, nil
+}
+
+func newExporter(ctx context.Context, cfg Config) (sdktrace.SpanExporter, io.Closer, error) {
+	switch cfg.Exporter {
+	case ExporterOTLP:
+		exporter, err := newOTLPExporter(ctx, cfg)
+		if err != nil {
+			return nil, nil, fmt.Errorf("tracing.Setup: otlp exporter: %w", err)
+		}
+		return exporter, nil, nil
+	case ExporterStdout:
+		var (
+			w      io.Writer = os.Stdout
+			closer io.Closer
+		)
+		if cfg.File != "" {
+			f, err := os.OpenFile(cfg.File, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+			if err != nil {
+				return nil, nil, fmt.Errorf("tracing.Setup: %w", err)
+			}
+			w, closer = f, f
+		}
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(w))
+		if err != nil {
+			return nil, nil, fmt.Errorf("tracing.Setup: stdout exporter: %w", err)
+		}
+		return exporter, closer, nil
+	default:
+		return nil, nil, fmt.Errorf("tracing.Setup: unknown exporter %q", cfg.Exporter)
+	}
+}
+
+func newOTLPExporter(ctx context.Context, cfg Config) (sdktrace.SpanExporter, error) {
+	switch cfg.Protocol {
+	case ProtocolGRPC:
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint)}
+		if cfg.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		return otlptracegrpc.New(ctx, opts...)
+	case ProtocolHTTP:
+		opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(cfg.Endpoint)}
+		if cfg.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		return otlptracehttp.New(ctx, opts...)
+	default:
+		return nil, fmt.Errorf("unknown protocol %q", cfg.Protocol)
+	}
+}
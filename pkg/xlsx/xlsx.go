@@ -0,0 +1,153 @@
+// Package xlsx writes Office Open XML workbooks row by row
+package xlsx
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const (
+	_contentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`</Types>`
+
+	_rootRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+		`</Relationships>`
+
+	_workbookRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+		`</Relationships>`
+
+	_workbook = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<sheets><sheet name="%s" sheetId="1" r:id="rId1"/></sheets></workbook>`
+
+	_sheetStart = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+	_sheetEnd = `</sheetData></worksheet>`
+
+	// _maxSheetName is the longest sheet name spreadsheet applications accept
+	_maxSheetName = 31
+)
+
+var ErrClosed = errors.New("xlsx: writer is closed")
+
+// Writer writes a workbook with a single worksheet. Rows are compressed and
+// written to the underlying writer as they come, so the workbook is never held in memory.
+type Writer struct {
+	zip    *zip.Writer
+	sheet  *bufio.Writer
+	row    int
+	closed bool
+}
+
+// NewWriter starts a workbook on w whose worksheet is named sheetName.
+func NewWriter(w io.Writer, sheetName string) (*Writer, error) {
+	if runes := []rune(sheetName); len(runes) > _maxSheetName {
+		sheetName = string(runes[:_maxSheetName])
+	}
+	var name strings.Builder
+	if err := xml.EscapeText(&name, []byte(sheetName)); err != nil {
+		return nil, err
+	}
+
+	zw := zip.NewWriter(w)
+	parts := []struct{ name, content string }{
+		{"[Content_Types].xml", _contentTypes},
+		{"_rels/.rels", _rootRels},
+		{"xl/workbook.xml", fmt.Sprintf(_workbook, name.String())},
+		{"xl/_rels/workbook.xml.rels", _workbookRels},
+	}
+	for _, part := range parts {
+		f, err := zw.Create(part.name)
+		if err != nil {
+			return nil, fmt.Errorf("xlsx: %w", err)
+		}
+		if _, err := io.WriteString(f, part.content); err != nil {
+			return nil, fmt.Errorf("xlsx: %w", err)
+		}
+	}
+	f, err := zw.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, fmt.Errorf("xlsx: %w", err)
+	}
+	sheet := bufio.NewWriter(f)
+	if _, err := sheet.WriteString(_sheetStart); err != nil {
+		return nil, fmt.Errorf("xlsx: %w", err)
+	}
+	return &Writer{zip: zw, sheet: sheet}, nil
+}
+
+// WriteRow appends a row. Integers and floats become numeric cells, nil an
+// empty cell and every other value a text cell.
+func (w *Writer) WriteRow(cells ...any) error {
+	if w.closed {
+		return ErrClosed
+	}
+	w.row++
+	fmt.Fprintf(w.sheet, `<row r="%d">`, w.row)
+	for i, cell := range cells {
+		ref := columnName(i) + strconv.Itoa(w.row)
+		switch v := cell.(type) {
+		case nil:
+		case int:
+			fmt.Fprintf(w.sheet, `<c r="%s"><v>%d</v></c>`, ref, v)
+		case int32:
+			fmt.Fprintf(w.sheet, `<c r="%s"><v>%d</v></c>`, ref, v)
+		case int64:
+			fmt.Fprintf(w.sheet, `<c r="%s"><v>%d</v></c>`, ref, v)
+		case float64:
+			fmt.Fprintf(w.sheet, `<c r="%s"><v>%s</v></c>`, ref, strconv.FormatFloat(v, 'f', -1, 64))
+		default:
+			fmt.Fprintf(w.sheet, `<c r="%s" t="inlineStr"><is><t xml:space="preserve">`, ref)
+			if err := xml.EscapeText(w.sheet, []byte(fmt.Sprint(v))); err != nil {
+				return fmt.Errorf("xlsx: %w", err)
+			}
+			w.sheet.WriteString(`</t></is></c>`)
+		}
+	}
+	if _, err := w.sheet.WriteString(`</row>`); err != nil {
+		return fmt.Errorf("xlsx: %w", err)
+	}
+	return nil
+}
+
+// Close finishes the workbook. It does not close the underlying writer.
+func (w *Writer) Close() error {
+	if w.closed {
+		return ErrClosed
+	}
+	w.closed = true
+	if _, err := w.sheet.WriteString(_sheetEnd); err != nil {
+		return fmt.Errorf("xlsx: %w", err)
+	}
+	if err := w.sheet.Flush(); err != nil {
+		return fmt.Errorf("xlsx: %w", err)
+	}
+	if err := w.zip.Close(); err != nil {
+		return fmt.Errorf("xlsx: %w", err)
+	}
+	return nil
+}
+
+// columnName returns the letters of the zero-based column i, e.g. "A", "Z", "AA".
+func columnName(i int) string {
+	name := ""
+	for i++; i > 0; i = (i - 1) / 26 {
+		name = string(rune('A'+(i-1)%26)) + name
+	}
+	return name
+}
@@ -0,0 +1,64 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, "Players & Staff")
+	require.NoError(t, err)
+	require.NoError(t, w.WriteRow("name", "age", "height"))
+	require.NoError(t, w.WriteRow("O'Neal <Shaq>", 52, 2.16))
+	require.NoError(t, w.WriteRow(nil, int64(7)))
+	require.NoError(t, w.Close())
+	assert.ErrorIs(t, w.WriteRow("late"), ErrClosed)
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	parts := map[string]string{}
+	for _, f := range zr.File {
+		r, err := f.Open()
+		require.NoError(t, err)
+		data, err := io.ReadAll(r)
+		require.NoError(t, err)
+		parts[f.Name] = string(data)
+	}
+	require.Contains(t, parts, "[Content_Types].xml")
+	require.Contains(t, parts, "_rels/.rels")
+	require.Contains(t, parts, "xl/_rels/workbook.xml.rels")
+	assert.Contains(t, parts["xl/workbook.xml"], `name="Players &amp; Staff"`)
+
+	var sheet struct {
+		Rows []struct {
+			Ref   string `xml:"r,attr"`
+			Cells []struct {
+				Ref    string `xml:"r,attr"`
+				Type   string `xml:"t,attr"`
+				Value  string `xml:"v"`
+				Inline string `xml:"is>t"`
+			} `xml:"c"`
+		} `xml:"sheetData>row"`
+	}
+	require.NoError(t, xml.Unmarshal([]byte(parts["xl/worksheets/sheet1.xml"]), &sheet))
+	require.Len(t, sheet.Rows, 3)
+	assert.Equal(t, "O'Neal <Shaq>", sheet.Rows[1].Cells[0].Inline)
+	assert.Equal(t, "inlineStr", sheet.Rows[1].Cells[0].Type)
+	assert.Equal(t, "52", sheet.Rows[1].Cells[1].Value)
+	assert.Equal(t, "2.16", sheet.Rows[1].Cells[2].Value)
+	require.Len(t, sheet.Rows[2].Cells, 1)
+	assert.Equal(t, "B3", sheet.Rows[2].Cells[0].Ref)
+}
+
+func TestColumnName(t *testing.T) {
+	for i, want := range map[int]string{0: "A", 25: "Z", 26: "AA", 27: "AB", 701: "ZZ", 702: "AAA"} {
+		assert.Equal(t, want, columnName(i))
+	}
+}